@@ -0,0 +1,296 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// FillMethod defines how rows introduced by Reindex or Align are filled.
+type FillMethod int
+
+const (
+	// FILL_NULL fills introduced rows with null (NaN for floats, nil for
+	// pointers and interfaces, zero value otherwise).
+	FILL_NULL FillMethod = 0
+
+	// FILL_FORWARD fills introduced rows with values of the row with the
+	// nearest lower key. It requires IsLessThanFunc of the key series.
+	FILL_FORWARD FillMethod = 1
+
+	// FILL_BACKWARD fills introduced rows with values of the row with the
+	// nearest greater key. It requires IsLessThanFunc of the key series.
+	FILL_BACKWARD FillMethod = 2
+)
+
+// JoinHow defines which keys are kept by Align.
+type JoinHow int
+
+const (
+	// OUTER keeps union of keys of both dataframes.
+	OUTER JoinHow = 0
+
+	// INNER keeps intersection of keys of both dataframes.
+	INNER JoinHow = 1
+
+	// LEFT keeps keys of the first dataframe.
+	LEFT JoinHow = 2
+
+	// RIGHT keeps keys of the second dataframe.
+	RIGHT JoinHow = 3
+)
+
+// ReindexOptions is defined as an optional parameters
+// for Reindex(...).
+//
+// Defaults:
+// 		ReindexOptions { Key: "", DontLock: false }
+//
+// Properties:
+//	• `Key` - name of the key series. If not set, the index of the dataframe
+//	   is used or the name of the new keys series if the index is not set
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type ReindexOptions struct {
+	Key string
+	DontLock bool
+}
+
+// AlignOptions is defined as an optional parameters
+// for Align(...).
+//
+// Defaults:
+// 		AlignOptions { Key: "", Fill: FILL_NULL, DontLock: false }
+//
+// Properties:
+//	• `Key` - name of the key series. If not set, the index of the first
+//	   dataframe is used
+//	• `Fill` - fill method of introduced rows
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type AlignOptions struct {
+	Key string
+	Fill FillMethod
+	DontLock bool
+}
+
+// Reindex returns a new dataframe containing a row for every value of newKeys (in
+// their order). Rows of df are matched by the key series using IsEqualFunc. Keys
+// which are not present in df are filled according to fill. Keys of df must be
+// unique and newKeys must be of the same type as the key series.
+//
+// Example:
+//
+//	// minute bars with missing minutes filled by the last known bar
+//	bars, err = Reindex(ctx, bars, minutes, FILL_FORWARD)
+//
+func Reindex(ctx context.Context, df *DataFrame, newKeys SeriesAny, fill FillMethod, options ...ReindexOptions) (*DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+		newKeys.RLock()
+		defer newKeys.RUnlock()
+	}
+
+	key := opts.Key
+	if key == "" {
+		if df.index != nil {
+			key = df.index.name
+		} else {
+			key = newKeys.Name(dontLock)
+		}
+	}
+
+	return reindex(ctx, df, key, newKeys, fill)
+}
+
+// Align returns copies of dataframes a and b with identical key series. Keys are
+// chosen according to how and ordered by IsLessThanFunc of the key series, or
+// in order of their first appearance if IsLessThanFunc is not set. Introduced
+// rows are filled according to AlignOptions.Fill. Keys of both dataframes must
+// be unique.
+//
+// Example:
+//
+//	eurusd, gbpusd, err = Align(ctx, eurusd, gbpusd, INNER, AlignOptions { Key: "time" })
+//
+func Align(ctx context.Context, a, b *DataFrame, how JoinHow, options ...AlignOptions) (*DataFrame, *DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		a.lock.RLock()
+		defer a.lock.RUnlock()
+		if b != a {
+			b.lock.RLock()
+			defer b.lock.RUnlock()
+		}
+	}
+
+	key := opts.Key
+	if key == "" {
+		if a.index == nil {
+			return nil, nil, errors.New("key is not set")
+		}
+		key = a.index.name
+	}
+
+	as, err := keySeries(a, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bs, err := keySeries(b, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if as.Type() != bs.Type() {
+		return nil, nil, fmt.Errorf("key series have different types: %s, %s", as.Type(), bs.Type())
+	}
+
+	keys := newKeyIndex(as.IsEqualAnyFunc)
+
+	switch how {
+	case OUTER:
+		for _, s := range []SeriesAny{as, bs} {
+			for row := 0; row < s.NRows(dontLock); row++ {
+				keys.add(s.ValueAny(row, dontLock))
+			}
+		}
+	case INNER:
+		inB := newKeyIndex(bs.IsEqualAnyFunc)
+		for row := 0; row < bs.NRows(dontLock); row++ {
+			inB.add(bs.ValueAny(row, dontLock))
+		}
+		for row := 0; row < as.NRows(dontLock); row++ {
+			v := as.ValueAny(row, dontLock)
+			if _, ok := inB.find(v); ok {
+				keys.add(v)
+			}
+		}
+	case LEFT, RIGHT:
+		s := as
+		if how == RIGHT {
+			s = bs
+		}
+		for row := 0; row < s.NRows(dontLock); row++ {
+			keys.add(s.ValueAny(row, dontLock))
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown join: %d", how)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	newKeys := as.emptyCopy(keys.len())
+	for _, k := range keyOrder(as, keys) {
+		newKeys.AppendAny(keys.keys[k], dontLock)
+	}
+
+	na, err := reindex(ctx, a, key, newKeys, opts.Fill)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nb, err := reindex(ctx, b, key, newKeys, opts.Fill)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return na, nb, nil
+}
+
+// keySeries returns the key series of df
+func keySeries(df *DataFrame, key string) (SeriesAny, error) {
+	col, err := df.NameToColumn(key, dontLock)
+	if err != nil {
+		return nil, errors.New(err.Error() + ": " + key)
+	}
+	return df.Series[col], nil
+}
+
+func reindex(ctx context.Context, df *DataFrame, key string, newKeys SeriesAny, fill FillMethod) (*DataFrame, error) {
+	ks, err := keySeries(df, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if ks.Type() != newKeys.Type() {
+		return nil, fmt.Errorf("new keys must be of type %s: %s", ks.Type(), newKeys.Type())
+	}
+
+	if fill != FILL_NULL && !ks.hasIsLessThanFunc() {
+		return nil, errors.New("fill requires IsLessThanFunc of the key series: " + key)
+	}
+
+	rows := newKeyIndex(ks.IsEqualAnyFunc)
+	for row := 0; row < df.n; row++ {
+		if _, added := rows.add(ks.ValueAny(row, dontLock)); !added {
+			return nil, fmt.Errorf("duplicate key: %v", ks.ValueAny(row, dontLock))
+		}
+	}
+
+	// rows of df ordered by keys for fills
+	var sorted []int
+	if fill != FILL_NULL {
+		sorted = make([]int, df.n)
+		for i := range sorted {
+			sorted[i] = i
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return ks.IsLessThanAnyFunc(ks.ValueAny(sorted[i], dontLock), ks.ValueAny(sorted[j], dontLock))
+		})
+	}
+
+	nRows := newKeys.NRows(dontLock)
+	ndf := df.emptyCopy(nRows)
+	ndf.n = nRows
+
+	for i := 0; i < nRows; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		v := newKeys.ValueAny(i, dontLock)
+
+		src, ok := rows.find(v)
+		if !ok {
+			src = -1
+
+			switch fill {
+			case FILL_FORWARD:
+				// first row with key greater than v
+				pos := sort.Search(len(sorted), func(j int) bool {
+					return ks.IsLessThanAnyFunc(v, ks.ValueAny(sorted[j], dontLock))
+				})
+				if pos > 0 {
+					src = sorted[pos-1]
+				}
+			case FILL_BACKWARD:
+				// first row with key not less than v
+				pos := sort.Search(len(sorted), func(j int) bool {
+					return !ks.IsLessThanAnyFunc(ks.ValueAny(sorted[j], dontLock), v)
+				})
+				if pos < len(sorted) {
+					src = sorted[pos]
+				}
+			}
+		}
+
+		for c, s := range df.Series {
+			switch {
+			case s == ks:
+				ndf.Series[c].AppendAny(v, dontLock)
+			case src < 0:
+				ndf.Series[c].appendNulls(1)
+			default:
+				ndf.Series[c].AppendAny(s.ValueAny(src, dontLock), dontLock)
+			}
+		}
+	}
+
+	return ndf, nil
+}
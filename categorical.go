@@ -0,0 +1,660 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// CategoricalInit is used to initialize Categorical series.
+//
+// Properties:
+//	• `Size` - prefill the series with Size null values
+//	• `Capacity` - how much memory to preallocate
+//	• `MaxLen` - maximal number of rows, appending to the full series evicts the
+//	   oldest rows
+//	• `Categories` - initial categories. Values which are not in categories are added
+//	   as new categories at the end
+//	• `Ordered` - if true, values are ordered by positions of their categories, otherwise
+//	   they are ordered as strings
+type CategoricalInit struct {
+	Size, Capacity, MaxLen int
+	Categories []string
+	Ordered bool
+}
+
+// Categorical is a dictionary-encoded series of strings. Every value is stored as
+// an integer code referencing its category, nulls are stored as -1. Categorical
+// implements SeriesAny, values are strings or nil for nulls.
+type Categorical struct {
+	codes *Series[int32]
+
+	dict    *dictionary
+	ordered bool
+
+	valFormatter ValueToStringFormatter
+
+	isEqualFunc, isLessThanFunc CompareFn[any]
+}
+
+// NewCategorical creates a categorical series with defined name. Size of the series
+// and categories can be set by passing `init`. Series can also by filled by data
+// passed as vals.
+//
+// Example:
+//
+//	side := NewCategorical("side", &CategoricalInit { Categories: []string { "buy", "sell" } }, "buy", "buy", "sell")
+//
+func NewCategorical(name string, init *CategoricalInit, vals ...string) *Categorical {
+	if init == nil {
+		init = &CategoricalInit{}
+	}
+
+	c := &Categorical{
+		codes:        NewSeries[int32](name, &SeriesInit{Capacity: init.Capacity}),
+		dict:         &dictionary{lookup: map[string]int32{}},
+		ordered:      init.Ordered,
+		valFormatter: DefaultValueFormatter,
+	}
+
+	for _, category := range init.Categories {
+		if _, ok := c.dict.lookup[category]; ok {
+			panic("categories must be unique: " + category)
+		}
+		c.code(category)
+	}
+
+	codes := make([]int32, len(vals))
+	for i, v := range vals {
+		codes[i] = c.code(v)
+	}
+	c.appendCodes(codes)
+
+	c.appendNulls(init.Size - len(vals))
+	c.codes.SetMaxLen(init.MaxLen, dontLock)
+
+	return c
+}
+
+// dictionary maps categories to codes. Categories are only appended to the
+// dictionary, so it can be shared by views of the series.
+type dictionary struct {
+	categories []string
+	lookup     map[string]int32
+}
+
+// copy returns a copy of the dictionary
+func (d *dictionary) copy() *dictionary {
+	nd := &dictionary{
+		categories: append([]string{}, d.categories...),
+		lookup:     make(map[string]int32, len(d.lookup)),
+	}
+
+	for k, v := range d.lookup {
+		nd.lookup[k] = v
+	}
+
+	return nd
+}
+
+// code returns code of category v, the category is added if it does not exist
+func (c *Categorical) code(v string) int32 {
+	if code, ok := c.dict.lookup[v]; ok {
+		return code
+	}
+
+	code := int32(len(c.dict.categories))
+	c.dict.categories = append(c.dict.categories, v)
+	c.dict.lookup[v] = code
+
+	return code
+}
+
+// encode returns code of value v which must be a string or nil
+func (c *Categorical) encode(v any) int32 {
+	switch t := v.(type) {
+	case nil:
+		return -1
+	case string:
+		return c.code(t)
+	case *string:
+		if t == nil {
+			return -1
+		}
+		return c.code(*t)
+	}
+	panic(fmt.Sprintf("invalid value for categorical: %T", v))
+}
+
+// encodeAll returns codes of a value or a slice of values
+func (c *Categorical) encodeAll(val any) []int32 {
+	switch t := val.(type) {
+	case []string:
+		codes := make([]int32, len(t))
+		for i, v := range t {
+			codes[i] = c.code(v)
+		}
+		return codes
+	case []any:
+		codes := make([]int32, len(t))
+		for i, v := range t {
+			codes[i] = c.encode(v)
+		}
+		return codes
+	}
+	return []int32{c.encode(val)}
+}
+
+// decode returns value of code
+func (c *Categorical) decode(code int32) any {
+	if code < 0 {
+		return nil
+	}
+	return c.dict.categories[code]
+}
+
+// Categories returns categories of the series.
+func (c *Categorical) Categories(options ...Options) []string {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.RLock(); defer c.RUnlock()
+	}
+
+	return append([]string{}, c.dict.categories...)
+}
+
+// Codes returns codes of values, nulls are -1.
+func (c *Categorical) Codes(options ...Options) []int32 {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.RLock(); defer c.RUnlock()
+	}
+
+	return append([]int32{}, c.codes.Values...)
+}
+
+// Ordered returns true if values are ordered by positions of their categories.
+func (c *Categorical) Ordered() bool {
+	return c.ordered
+}
+
+// SetCategories replaces categories and sets whether they are ordered. Values
+// which are not in categories are set to null.
+func (c *Categorical) SetCategories(categories []string, ordered bool, options ...Options) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.Lock(); defer c.Unlock()
+	}
+
+	// views share codes and the dictionary, they keep both unchanged
+	c.codes.realloc()
+
+	old := c.dict.categories
+
+	c.dict, c.ordered = &dictionary{lookup: map[string]int32{}}, ordered
+	for _, category := range categories {
+		if _, ok := c.dict.lookup[category]; ok {
+			panic("categories must be unique: " + category)
+		}
+		c.code(category)
+	}
+
+	for i, code := range c.codes.Values {
+		if code < 0 {
+			continue
+		}
+		if nc, ok := c.dict.lookup[old[code]]; ok {
+			c.codes.Values[i] = nc
+		} else {
+			c.codes.Values[i] = -1
+		}
+	}
+}
+
+// Value returns the value of a particular row and false if the value is null.
+func (c *Categorical) Value(row int, options ...Options) (string, bool) {
+	code := c.codes.Value(row, options...)
+	if code < 0 {
+		return "", false
+	}
+	return c.dict.categories[code], true
+}
+
+// Name returns the series name.
+func (c *Categorical) Name(options ...Options) string {
+	return c.codes.Name(options...)
+}
+
+// Rename renames the series.
+func (c *Categorical) Rename(n string, options ...Options) {
+	c.codes.Rename(n, options...)
+}
+
+// Type returns type of the series as string value.
+func (c *Categorical) Type() string {
+	return "categorical"
+}
+
+// NRows returns how many rows the series contains.
+func (c *Categorical) NRows(options ...Options) int {
+	return c.codes.NRows(options...)
+}
+
+// ValueAny returns the value of a particular row, nil for null.
+func (c *Categorical) ValueAny(row int, options ...Options) any {
+	return c.decode(c.codes.Value(row, options...))
+}
+
+// ValueString returns a string representation of a
+// particular row.
+func (c *Categorical) ValueString(row int, options ...Options) string {
+	return c.valFormatter(c.ValueAny(row, options...))
+}
+
+// PrependAny is used to set a value to the beginning of the
+// series. Value can be a string, nil, []string or []any.
+func (c *Categorical) PrependAny(val any, options ...Options) {
+	c.InsertAny(0, val, options...)
+}
+
+// AppendAny is used to set a value to the end of the series.
+// Value can be a string, nil, []string or []any.
+func (c *Categorical) AppendAny(val any, options ...Options) int {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.Lock(); defer c.Unlock()
+	}
+
+	row := len(c.codes.Values)
+	c.codes.insert(row, c.encodeAll(val))
+	return row
+}
+
+// InsertAny is used to set a value at an arbitrary row in
+// the series. All existing values from that row onwards
+// are shifted by 1. Value can be a string, nil, []string or []any.
+func (c *Categorical) InsertAny(row int, val any, options ...Options) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.Lock(); defer c.Unlock()
+	}
+
+	c.codes.insert(row, c.encodeAll(val))
+}
+
+// Remove is used to delete the value of a particular row.
+func (c *Categorical) Remove(row int, options ...Options) {
+	c.codes.Remove(row, options...)
+}
+
+// Reset is used clear all data contained in the Series.
+// Categories are kept.
+func (c *Categorical) Reset(options ...Options) {
+	c.codes.Reset(options...)
+}
+
+// UpdateAny is used to update the value of a particular row.
+// Value can be a string or nil.
+func (c *Categorical) UpdateAny(row int, val any, options ...Options) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.Lock(); defer c.Unlock()
+	}
+
+	c.codes.Update(row, c.encode(val), dontLock)
+}
+
+// IteratorAny will return a iterator that can be used to iterate through all the values.
+func (c *Categorical) IteratorAny(options ...IteratorOptions) Iterator[any] {
+	next := c.codes.valuesIterator(options...)
+
+	return NewIterator(func() (int, any, int, bool) {
+		row, code, total, ok := next()
+		if !ok {
+			return row, nil, total, ok
+		}
+		return row, c.decode(code), total, ok
+	})
+}
+
+// SetValueToStringFormatter is used to set a function
+// to convert the value of a particular row to a string
+// representation.
+func (c *Categorical) SetValueToStringFormatter(f ValueToStringFormatter) {
+	if f == nil {
+		c.valFormatter = DefaultValueFormatter
+		return
+	}
+	c.valFormatter = f
+}
+
+// Swap is used to swap 2 values based on their row position.
+func (c *Categorical) Swap(row1, row2 int, options ...Options) {
+	c.codes.Swap(row1, row2, options...)
+}
+
+// IsEqualAnyFunc returns true if a is equal to b.
+func (c *Categorical) IsEqualAnyFunc(a, b any) bool {
+	if c.isEqualFunc != nil {
+		return c.isEqualFunc(a, b)
+	}
+	return a == b
+}
+
+// IsLessThanAnyFunc returns true if a is less than b. Nulls are less than
+// other values. Values of ordered categoricals are compared by positions
+// of their categories.
+func (c *Categorical) IsLessThanAnyFunc(a, b any) bool {
+	if c.isLessThanFunc != nil {
+		return c.isLessThanFunc(a, b)
+	}
+
+	if c.ordered {
+		return c.encodeKnown(a) < c.encodeKnown(b)
+	}
+
+	if a == nil {
+		return b != nil
+	}
+	if b == nil {
+		return false
+	}
+	return a.(string) < b.(string)
+}
+
+// encodeKnown returns code of an existing category, -1 for nil
+func (c *Categorical) encodeKnown(v any) int32 {
+	if v == nil {
+		return -1
+	}
+	code, ok := c.dict.lookup[v.(string)]
+	if !ok {
+		panic("unknown category: " + v.(string))
+	}
+	return code
+}
+
+// SetIsEqualAnyFunc sets a function which can be used to determine
+// if 2 values in the series are equal.
+func (c *Categorical) SetIsEqualAnyFunc(f CompareFn[any]) {
+	c.isEqualFunc = f
+}
+
+// SetIsLessThanAnyFunc sets a function which can be used to determine
+// if a value is less than another in the series.
+func (c *Categorical) SetIsLessThanAnyFunc(f CompareFn[any]) {
+	c.isLessThanFunc = f
+}
+
+// Sort will sort the series.
+// It will return true if sorting was completed or false when the context is canceled.
+func (c *Categorical) Sort(ctx context.Context, options ...SortOptions) (completed bool) {
+	defer func() {
+		if x := recover(); x != nil {
+			completed = false
+		}
+	}()
+
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.Lock(); defer c.Unlock()
+	}
+
+	c.codes.detach()
+	codes := c.codes.Values
+
+	sortFunc := func(i, j int) bool {
+		if err := ctx.Err(); err != nil {
+			panic(err)
+		}
+
+		a, b := c.decode(codes[i]), c.decode(codes[j])
+
+		if opts.Desc {
+			return !c.IsLessThanAnyFunc(a, b)
+		}
+		return c.IsLessThanAnyFunc(a, b)
+	}
+
+	if opts.Stable {
+		sort.SliceStable(codes, sortFunc)
+	} else {
+		sort.Slice(codes, sortFunc)
+	}
+
+	return true
+}
+
+// Copy will create a new copy of the series.
+func (c *Categorical) Copy(options ...RangeOptions) *Categorical {
+	nc := c.emptyCopy(0).(*Categorical)
+	nc.codes = c.codes.Copy(options...)
+	return nc
+}
+
+// CopyAny will create a new copy of the series.
+func (c *Categorical) CopyAny(options ...RangeOptions) SeriesAny {
+	return c.Copy(options...)
+}
+
+// ShiftAny returns a new series with values shifted by n rows.
+// Vacated rows are null.
+func (c *Categorical) ShiftAny(n int, options ...Options) SeriesAny {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.RLock(); defer c.RUnlock()
+	}
+
+	nc := c.emptyCopy(0).(*Categorical)
+	nc.codes = c.codes.Shift(n, dontLock)
+
+	// vacated rows contain zero codes
+	for i := range nc.codes.Values {
+		if j := i - n; j < 0 || j >= len(nc.codes.Values) {
+			nc.codes.Values[i] = -1
+		}
+	}
+
+	return nc
+}
+
+// SetMaxLen caps the series to n rows. Appending to the full series evicts
+// the oldest rows. If n is 0, the series is not capped.
+func (c *Categorical) SetMaxLen(n int, options ...Options) {
+	c.codes.SetMaxLen(n, options...)
+}
+
+// MaxLen returns the maximal number of rows of the capped series or 0
+// if the series is not capped.
+func (c *Categorical) MaxLen(options ...Options) int {
+	return c.codes.MaxLen(options...)
+}
+
+// Table will produce the Series in a table.
+func (c *Categorical) Table(options ...TableOptions) string {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		c.RLock(); defer c.RUnlock()
+	}
+
+	return seriesTable(c.codes.name, c.Type(), len(c.codes.Values), func(row int) string {
+		return c.valFormatter(c.decode(c.codes.Values[row]))
+	}, opts.Range)
+}
+
+// String implements the fmt.Stringer interface. It does not lock the Series.
+func (c *Categorical) String() string {
+	return seriesString(c.codes.name, len(c.codes.Values), func(row int) string {
+		return c.valFormatter(c.decode(c.codes.Values[row]))
+	})
+}
+
+// FillRandAny will fill a Series with random data. rnd must return strings or nil.
+func (c *Categorical) FillRandAny(rnd RandFn[any]) {
+	c.codes.FillRand(func() int32 {
+		return c.encode(rnd())
+	})
+}
+
+// IsEqualAny returns true if s2's values are equal to s.
+func (c *Categorical) IsEqualAny(ctx context.Context, s2 SeriesAny, options ...IsEqualOptions) (bool, error) {
+	opts := DefaultOptions(options...)
+
+	c2, ok := s2.(*Categorical)
+	if !ok {
+		return false, errors.New("series is not categorical")
+	}
+
+	if !opts.DontLock {
+		c.RLock(); defer c.RUnlock()
+		if c2 != c {
+			c2.RLock(); defer c2.RUnlock()
+		}
+	}
+
+	if len(c.codes.Values) != len(c2.codes.Values) {
+		return false, nil
+	}
+
+	if opts.CheckName && c.codes.name != c2.codes.name {
+		return false, nil
+	}
+
+	for i, code := range c.codes.Values {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		if !c.IsEqualAnyFunc(c.decode(code), c2.decode(c2.codes.Values[i])) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Lock locks the series for writing.
+func (c *Categorical) Lock() {
+	c.codes.Lock()
+}
+
+// Unlock unlocks the series locked for writing.
+func (c *Categorical) Unlock() {
+	c.codes.Unlock()
+}
+
+// RLock locks the series for reading.
+func (c *Categorical) RLock() {
+	c.codes.RLock()
+}
+
+// RUnlock unlocks the series locked for reading.
+func (c *Categorical) RUnlock() {
+	c.codes.RUnlock()
+}
+
+func (c *Categorical) cloneAsEmpty(size ...int) SeriesAny {
+	nc := c.emptyCopy(0).(*Categorical)
+	nc.codes = c.codes.cloneAsEmpty(size...).(*Series[int32])
+	for i := range nc.codes.Values {
+		nc.codes.Values[i] = -1
+	}
+	return nc
+}
+
+func (c *Categorical) emptyCopy(capacity int) SeriesAny {
+	nc := &Categorical{
+		codes:          c.codes.emptyCopy(capacity).(*Series[int32]),
+		dict:           c.dict.copy(),
+		ordered:        c.ordered,
+		valFormatter:   c.valFormatter,
+		isEqualFunc:    c.isEqualFunc,
+		isLessThanFunc: c.isLessThanFunc,
+	}
+
+	return nc
+}
+
+func (c *Categorical) assign(src SeriesAny) {
+	sc := src.(*Categorical)
+
+	c.codes.assign(sc.codes)
+	c.dict, c.ordered = sc.dict, sc.ordered
+	c.valFormatter, c.isEqualFunc, c.isLessThanFunc = sc.valFormatter, sc.isEqualFunc, sc.isLessThanFunc
+}
+
+func (c *Categorical) flushEvents() {
+	c.codes.flushEvents()
+}
+
+func (c *Categorical) swap(row1, row2 int) {
+	c.codes.swap(row1, row2)
+}
+
+func (c *Categorical) sorted() {
+	c.codes.sorted()
+}
+
+func (c *Categorical) window(start, end int) SeriesAny {
+	// the dictionary is shared, so categories added to c are visible for
+	// codes updated in the window
+	return &Categorical{
+		codes:          c.codes.window(start, end).(*Series[int32]),
+		dict:           c.dict,
+		ordered:        c.ordered,
+		valFormatter:   c.valFormatter,
+		isEqualFunc:    c.isEqualFunc,
+		isLessThanFunc: c.isLessThanFunc,
+	}
+}
+
+func (c *Categorical) appendSeries(src SeriesAny) {
+	sc := src.(*Categorical)
+
+	// codes of src categories in c
+	mapping := make([]int32, len(sc.dict.categories))
+	for i, category := range sc.dict.categories {
+		mapping[i] = c.code(category)
+	}
+
+	codes := make([]int32, len(sc.codes.Values))
+	for i, code := range sc.codes.Values {
+		if code >= 0 {
+			code = mapping[code]
+		}
+		codes[i] = code
+	}
+	c.appendCodes(codes)
+}
+
+func (c *Categorical) appendNulls(n int) {
+	if n <= 0 {
+		return
+	}
+
+	codes := make([]int32, n)
+	for i := range codes {
+		codes[i] = -1
+	}
+	c.appendCodes(codes)
+}
+
+// appendCodes appends codes by insert of the codes series, so values shared
+// with copies and views are not changed
+func (c *Categorical) appendCodes(codes []int32) {
+	c.codes.insert(len(c.codes.Values), codes)
+}
+
+func (c *Categorical) hasIsLessThanFunc() bool {
+	return true
+}
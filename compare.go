@@ -63,4 +63,39 @@ func IsLessThanPtrFunc[T constraints.Ordered] (f1, f2 *T) bool {
 	}
 
 	return IsLessThanFunc(*f1, *f2)
-}
\ No newline at end of file
+}
+
+// numericLess returns IsLessThanFunc of numeric type T or nil for other types
+func numericLess[T any]() CompareFn[T] {
+	var f any
+
+	switch any(*new(T)).(type) {
+	case float64:
+		f = CompareFn[float64](IsLessThanFunc[float64])
+	case float32:
+		f = CompareFn[float32](IsLessThanFunc[float32])
+	case int:
+		f = CompareFn[int](IsLessThanFunc[int])
+	case int64:
+		f = CompareFn[int64](IsLessThanFunc[int64])
+	case int32:
+		f = CompareFn[int32](IsLessThanFunc[int32])
+	case int16:
+		f = CompareFn[int16](IsLessThanFunc[int16])
+	case int8:
+		f = CompareFn[int8](IsLessThanFunc[int8])
+	case uint:
+		f = CompareFn[uint](IsLessThanFunc[uint])
+	case uint64:
+		f = CompareFn[uint64](IsLessThanFunc[uint64])
+	case uint32:
+		f = CompareFn[uint32](IsLessThanFunc[uint32])
+	case uint16:
+		f = CompareFn[uint16](IsLessThanFunc[uint16])
+	case uint8:
+		f = CompareFn[uint8](IsLessThanFunc[uint8])
+	}
+
+	less, _ := f.(CompareFn[T])
+	return less
+}
@@ -0,0 +1,167 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Axis defines the direction of concatenation.
+type Axis int
+
+const (
+	// ROWS is used to concatenate dataframes vertically, rows of all the
+	// dataframes are appended.
+	ROWS Axis = 0
+
+	// COLUMNS is used to concatenate dataframes horizontally, series of all
+	// the dataframes are put side by side.
+	COLUMNS Axis = 1
+)
+
+// ConcatOptions is defined as an optional parameters
+// for ConcatWithOptions(...).
+//
+// Defaults:
+// 		ConcatOptions { Union: false, DontLock: false }
+//
+// Properties:
+//	• `Union` - if true, dataframes with different series can be concatenated by rows.
+//	   Missing values are filled with null (NaN for floats, nil for pointers and
+//	   interfaces, zero value otherwise)
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type ConcatOptions struct {
+	Union, DontLock bool
+}
+
+// Concat concatenates dataframes into a new dataframe. Passed dataframes are not modified.
+//
+// When axis is ROWS, all the dataframes must contain series of the same names and types.
+// Order of the series is taken from the first dataframe. When axis is COLUMNS, all the
+// dataframes must contain the same number of rows and names of series must be unique.
+//
+// Example:
+//
+//	df, err := Concat(ctx, ROWS, monday, tuesday, wednesday)
+//
+func Concat(ctx context.Context, axis Axis, frames ...*DataFrame) (*DataFrame, error) {
+	return ConcatWithOptions(ctx, axis, frames)
+}
+
+// ConcatWithOptions concatenates dataframes into a new dataframe. See Concat.
+//
+// Example:
+//
+//	df, err := ConcatWithOptions(ctx, ROWS, []*DataFrame { bars, quotes }, ConcatOptions { Union: true })
+//
+func ConcatWithOptions(ctx context.Context, axis Axis, frames []*DataFrame, options ...ConcatOptions) (*DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		locked := map[*DataFrame]bool{}
+		for _, df := range frames {
+			if !locked[df] {
+				locked[df] = true
+				df.lock.RLock()
+				defer df.lock.RUnlock()
+			}
+		}
+	}
+
+	switch axis {
+	case ROWS:
+		return concatRows(ctx, frames, opts.Union)
+	case COLUMNS:
+		return concatColumns(ctx, frames)
+	}
+
+	return nil, fmt.Errorf("unknown axis: %d", axis)
+}
+
+func concatRows(ctx context.Context, frames []*DataFrame, union bool) (*DataFrame, error) {
+	if len(frames) == 0 {
+		return NewDataFrame(), nil
+	}
+
+	// Collect series in order of the first appearance
+	names := []string{}
+	first := map[string]SeriesAny{}
+
+	var nRows int
+
+	for i, df := range frames {
+		nRows += df.n
+
+		for _, s := range df.Series {
+			name := s.Name(dontLock)
+
+			f, ok := first[name]
+			if !ok {
+				if i > 0 && !union {
+					return nil, errors.New("series not present in all dataframes: " + name)
+				}
+				names = append(names, name)
+				first[name] = s
+				continue
+			}
+
+			if f.Type() != s.Type() {
+				return nil, fmt.Errorf("series %s has different types: %s and %s", name, f.Type(), s.Type())
+			}
+		}
+
+		if !union && len(df.Series) != len(names) {
+			return nil, errors.New("dataframes contain different series")
+		}
+	}
+
+	series := make([]SeriesAny, 0, len(names))
+
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		ns := first[name].emptyCopy(nRows)
+
+		for _, df := range frames {
+			col, err := df.NameToColumn(name, dontLock)
+			if err != nil {
+				ns.appendNulls(df.n)
+				continue
+			}
+			ns.appendSeries(df.Series[col])
+		}
+
+		series = append(series, ns)
+	}
+
+	return NewDataFrame(series...), nil
+}
+
+func concatColumns(ctx context.Context, frames []*DataFrame) (*DataFrame, error) {
+	names := map[string]bool{}
+	series := []SeriesAny{}
+
+	for i, df := range frames {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if i > 0 && df.n != frames[0].n {
+			return nil, errors.New("different number of rows in dataframes")
+		}
+
+		for _, s := range df.Series {
+			name := s.Name(dontLock)
+			if names[name] {
+				return nil, errors.New("names of series must be unique: " + name)
+			}
+			names[name] = true
+
+			series = append(series, s.CopyAny())
+		}
+	}
+
+	return NewDataFrame(series...), nil
+}
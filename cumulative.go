@@ -0,0 +1,243 @@
+package dataframe
+
+import (
+	"math"
+	"sort"
+)
+
+// ReduceFn is used by Expanding.Reduce to compute a value from all the
+// values of the window. vals must not be modified.
+type ReduceFn func(vals []float64) float64
+
+// CumulativeOptions is defined as an optional parameters
+// for cumulative and expanding-window operations.
+//
+// Defaults:
+// 		CumulativeOptions { SkipNaN: false, DontLock: false }
+//
+// Properties:
+//	• `SkipNaN` - if true, NaN values are skipped. Otherwise NaN is propagated
+//	   to all the following rows
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type CumulativeOptions struct {
+	SkipNaN, DontLock bool
+}
+
+// CumSum returns a new series with cumulative sums of values. Rows containing
+// NaN are NaN in the result.
+//
+// Example:
+//
+//	equity := CumSum(pnl)
+//
+func CumSum[T Number](s *Series[T], options ...CumulativeOptions) *Series[T] {
+	return cumulative(s, func(acc, v T) T { return acc + v }, options...)
+}
+
+// CumProd returns a new series with cumulative products of values. Rows containing
+// NaN are NaN in the result.
+func CumProd[T Number](s *Series[T], options ...CumulativeOptions) *Series[T] {
+	return cumulative(s, func(acc, v T) T { return acc * v }, options...)
+}
+
+// CumMax returns a new series with cumulative maximums of values. Rows containing
+// NaN are NaN in the result.
+//
+// Example:
+//
+//	peak := CumMax(equity)
+//
+func CumMax[T Number](s *Series[T], options ...CumulativeOptions) *Series[T] {
+	return cumulative(s, func(acc, v T) T {
+		if v > acc {
+			return v
+		}
+		return acc
+	}, options...)
+}
+
+// CumMin returns a new series with cumulative minimums of values. Rows containing
+// NaN are NaN in the result.
+func CumMin[T Number](s *Series[T], options ...CumulativeOptions) *Series[T] {
+	return cumulative(s, func(acc, v T) T {
+		if v < acc {
+			return v
+		}
+		return acc
+	}, options...)
+}
+
+// CumCount returns a new series with cumulative counts of values which are not NaN.
+// SkipNaN has no effect.
+func CumCount[T Number](s *Series[T], options ...CumulativeOptions) *Series[int] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	vals := make([]int, len(s.Values))
+
+	var count int
+	for i, v := range s.Values {
+		if v == v {
+			count++
+		}
+		vals[i] = count
+	}
+
+	return NewSeries(s.name, nil, vals...)
+}
+
+// cumulative accumulates values by fn
+func cumulative[T Number](s *Series[T], fn func(acc, v T) T, options ...CumulativeOptions) *Series[T] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := s.emptyCopy(len(s.Values)).(*Series[T])
+	ns.Values = ns.Values[:len(s.Values)]
+
+	var acc T
+	var started, propagate bool
+
+	for i, v := range s.Values {
+		switch {
+		case propagate:
+			ns.Values[i] = nullValue[T]()
+		case v != v:
+			// only floats can be NaN
+			ns.Values[i] = v
+			propagate = !opts.SkipNaN
+		case !started:
+			acc, started = v, true
+			ns.Values[i] = acc
+		default:
+			acc = fn(acc, v)
+			ns.Values[i] = acc
+		}
+	}
+
+	return ns
+}
+
+// Expanding is an expanding window over a numeric series. Value of a row is computed
+// from all the values from the beginning of the series up to the row (inclusive).
+type Expanding[T Number] struct {
+	s    *Series[T]
+	opts CumulativeOptions
+}
+
+// NewExpanding creates an expanding window over series s. If SkipNaN is true, NaN
+// values are excluded from windows, otherwise rows from the first NaN onward are NaN.
+//
+// Example:
+//
+//	e := NewExpanding(returns, CumulativeOptions { SkipNaN: true })
+//	mean, std := e.Mean(), e.Std()
+//
+func NewExpanding[T Number](s *Series[T], options ...CumulativeOptions) *Expanding[T] {
+	return &Expanding[T]{s: s, opts: DefaultOptions(options...)}
+}
+
+// Mean returns a new series with expanding means.
+func (e *Expanding[T]) Mean() *Series[float64] {
+	var sum float64
+	var n int
+
+	return e.expand(func(v float64) float64 {
+		sum += v
+		n++
+		return sum / float64(n)
+	})
+}
+
+// Std returns a new series with expanding sample standard deviations. The first
+// value of the window is NaN.
+func (e *Expanding[T]) Std() *Series[float64] {
+	// Welford's algorithm
+	var mean, m2 float64
+	var n int
+
+	return e.expand(func(v float64) float64 {
+		n++
+		delta := v - mean
+		mean += delta / float64(n)
+		m2 += delta * (v - mean)
+
+		if n < 2 {
+			return math.NaN()
+		}
+		return math.Sqrt(m2 / float64(n-1))
+	})
+}
+
+// Quantile returns a new series with expanding q-quantiles (0 <= q <= 1) computed
+// by linear interpolation.
+func (e *Expanding[T]) Quantile(q float64) *Series[float64] {
+	if q < 0 || q > 1 {
+		panic("quantile must be in range [0, 1]")
+	}
+
+	sorted := []float64{}
+
+	return e.expand(func(v float64) float64 {
+		pos := sort.SearchFloat64s(sorted, v)
+		sorted = append(sorted, 0)
+		copy(sorted[pos+1:], sorted[pos:])
+		sorted[pos] = v
+
+		return quantileSorted(sorted, q, LINEAR)
+	})
+}
+
+// Reduce returns a new series with values computed by fn from expanding windows.
+//
+// Example:
+//
+//	drawdown := e.Reduce(func (vals []float64) float64 {
+//		return vals[len(vals) - 1] - utils.Max(vals...)
+//	})
+//
+func (e *Expanding[T]) Reduce(fn ReduceFn) *Series[float64] {
+	vals := []float64{}
+
+	return e.expand(func(v float64) float64 {
+		vals = append(vals, v)
+		return fn(vals)
+	})
+}
+
+// expand calls fn for every value in the window and stores its results
+func (e *Expanding[T]) expand(fn func(v float64) float64) *Series[float64] {
+	s := e.s
+
+	if !e.opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	vals := make([]float64, len(s.Values))
+
+	last, propagate := math.NaN(), false
+
+	for i, v := range s.Values {
+		f := float64(v)
+
+		switch {
+		case propagate:
+		case f != f:
+			propagate = !e.opts.SkipNaN
+			if propagate {
+				last = math.NaN()
+			}
+		default:
+			last = fn(f)
+		}
+
+		vals[i] = last
+	}
+
+	return NewSeries(s.name, nil, vals...)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"sync"
 
 	"github.com/olekukonko/tablewriter"
 	"golang.org/x/sync/errgroup"
@@ -17,7 +16,14 @@ type DataFrame struct {
 	Series []SeriesAny
 	n      int // Number of rows
 
-	lock sync.RWMutex
+	maxLen int // Maximal number of rows, 0 if not capped
+
+	index *index
+
+	// subscriptions of changes
+	events hub[map[string]any]
+
+	lock txnLock
 }
 
 // NewDataFrame creates a dataframe from passed series.
@@ -82,7 +88,7 @@ func (df *DataFrame) Row(row int, options ...Options) map[string]any {
 	out := map[string]any{}
 
 	for _, aSeries := range df.Series {
-		out[aSeries.Name()] = aSeries.ValueAny(row)
+		out[aSeries.Name(options...)] = aSeries.ValueAny(row, options...)
 	}
 
 	return out
@@ -124,8 +130,9 @@ func (df *DataFrame) valuesIterator(options ...IteratorOptions) IteratorFn[map[s
 
 		out := map[string]any{}
 
+		sOpts := Options{DontLock: opts.DontLock}
 		for _, aSeries := range df.Series {
-			out[aSeries.Name()] = aSeries.ValueAny(row)
+			out[aSeries.Name(sOpts)] = aSeries.ValueAny(row, sOpts)
 		}
 
 		row = row + step
@@ -152,19 +159,90 @@ func (df *DataFrame) Append(vals any, options ...Options) {
 // Insert adds a row to a particular position.
 func (df *DataFrame) Insert(row int, vals any, options ...Options) {
 	opts := DefaultOptions(options...)
+
+	defer df.events.flush()
 	
 	if !opts.DontLock {
 		df.lock.Lock()
 		defer df.lock.Unlock()
 	}
 
+	n := df.n
 	df.insert(row, vals)
+	df.inserted(row, n)
+}
+
+// inserted queues events of the row inserted into n rows
+func (df *DataFrame) inserted(row, n int) {
+	if !df.events.active() {
+		return
+	}
+
+	evicted, newRow, count := insertedRange(row, n, 1, df.n)
+	if evicted > 0 {
+		df.events.enqueue(Event[map[string]any]{Kind: EVENT_EVICT, Count: evicted})
+	}
+
+	if count > 0 {
+		df.events.enqueue(Event[map[string]any]{
+			Kind: insertedKind(row, n), Row: newRow, Count: 1,
+			Values: []map[string]any{df.Row(newRow, dontLock)},
+		})
+	}
+}
+
+// updated queues the event of the updated row
+func (df *DataFrame) updated(row int) {
+	if !df.events.active() {
+		return
+	}
+
+	if row < 0 {
+		row = df.n + row
+	}
+
+	df.events.enqueue(Event[map[string]any]{
+		Kind: EVENT_UPDATE, Row: row, Count: 1,
+		Values: []map[string]any{df.Row(row, dontLock)},
+	})
+}
+
+// Subscribe calls fn with events of changes made by Append, Prepend, Insert,
+// Update, UpdateRow, Remove and Sort. Values of events are rows as maps of
+// series names to values. Events are delivered in the order of changes after
+// the lock is released. Changes made directly to the series are not reported,
+// subscribe to the series instead.
+//
+// Example:
+//
+//	sub := df.Subscribe(func(ev Event[map[string]any]) {
+//		if ev.Kind == EVENT_APPEND {
+//			fmt.Println(ev.Values[0]["price"])
+//		}
+//	}, SubscribeOptions { Buffer: 1024 })
+//	defer sub.Unsubscribe()
+//
+func (df *DataFrame) Subscribe(fn func(Event[map[string]any]), options ...SubscribeOptions) *Subscription[map[string]any] {
+	return df.events.subscribe(fn, DefaultOptions(options...))
 }
 
 func (df *DataFrame) insert(row int, vals any) {
 
 	var nRows = df.n
 
+	// rows evicted from a capped DataFrame are removed from the index
+	// while their keys are available
+	var evicted int
+	if df.maxLen > 0 && row == nRows && nRows >= df.maxLen {
+		evicted = nRows + 1 - df.maxLen
+		df.indexRemoving(0, evicted)
+	}
+
+	var completed bool
+	defer func(n int) {
+		df.indexInserted(row, n, evicted, completed)
+	}(nRows)
+
 	switch v := vals.(type) {
 	case map[string]any:
 
@@ -273,17 +351,29 @@ func (df *DataFrame) insert(row int, vals any) {
 	}
 
 	df.n = nRows
+	completed = true
 }
 
 // Remove deletes a row.
 func (df *DataFrame) Remove(row int, options ...Options) {
 	opts := DefaultOptions(options...)
 
+	defer df.events.flush()
+
 	if !opts.DontLock {
 		df.lock.Lock()
 		defer df.lock.Unlock()
 	}
 
+	if df.events.active() {
+		df.events.enqueue(Event[map[string]any]{
+			Kind: EVENT_REMOVE, Row: row, Count: 1,
+			Values: []map[string]any{df.Row(row, dontLock)},
+		})
+	}
+
+	df.indexRemoving(row, 1)
+
 	for i := range df.Series {
 		df.Series[i].Remove(row)
 	}
@@ -295,6 +385,8 @@ func (df *DataFrame) Remove(row int, options ...Options) {
 func (df *DataFrame) Update(row int, col any, val any, options ...Options) {
 	opts := DefaultOptions(options...)
 
+	defer df.events.flush()
+
 	if !opts.DontLock {
 		df.lock.Lock()
 		defer df.lock.Unlock()
@@ -305,13 +397,36 @@ func (df *DataFrame) Update(row int, col any, val any, options ...Options) {
 		col = df.MustNameToColumn(name, dontLock)
 	}
 
-	df.Series[col.(int)].UpdateAny(row, val)
+	df.update(row, col.(int), val)
+	df.updated(row)
+}
+
+// update updates the value of the series and the index if the series is
+// the key series
+func (df *DataFrame) update(row, col int, val any) {
+	s := df.Series[col]
+
+	if df.index == nil || s.Name(dontLock) != df.index.name {
+		s.UpdateAny(row, val)
+		return
+	}
+
+	if row < 0 {
+		row = df.n + row
+	}
+
+	df.indexUpdating(row)
+	defer df.indexUpdated(row)
+
+	s.UpdateAny(row, val)
 }
 
 // UpdateRow will update an entire row.
 func (df *DataFrame) UpdateRow(row int, vals any, options ...Options) {
 	opts := DefaultOptions(options...)
 
+	defer df.events.flush()
+
 	if !opts.DontLock {
 		df.lock.Lock()
 		defer df.lock.Unlock()
@@ -320,19 +435,19 @@ func (df *DataFrame) UpdateRow(row int, vals any, options ...Options) {
 	switch v := vals.(type) {
 	case map[string]any:
 		for name, val := range v {
-			df.Series[df.MustNameToColumn(name, dontLock)].UpdateAny(row, val)
+			df.update(row, df.MustNameToColumn(name, dontLock), val)
 		}
 	case map[int]any:
 		for idx, val := range v {
-			df.Series[idx].UpdateAny(row, val)
+			df.update(row, idx, val)
 		}
 	case map[any]any:
 		for C, val := range v {
 			switch CTyp := C.(type) {
 			case int:
-				df.Series[CTyp].UpdateAny(row, val)
+				df.update(row, CTyp, val)
 			case string:
-				df.Series[df.MustNameToColumn(CTyp, dontLock)].UpdateAny(row, val)
+				df.update(row, df.MustNameToColumn(CTyp, dontLock), val)
 			default:
 				panic("unknown type in UpdateRow argument. Must be an int or string.")
 			}
@@ -344,12 +459,14 @@ func (df *DataFrame) UpdateRow(row int, vals any, options ...Options) {
 		}
 
 		for idx, val := range v {
-			df.Series[idx].UpdateAny(row, val)
+			df.update(row, idx, val)
 		}
 
 	default:
 		panic("invalid type to update")
 	}
+
+	df.updated(row)
 }
 
 // Names will return a list of all the series names.
@@ -455,6 +572,11 @@ func (df *DataFrame) RemoveSeries(seriesName string, options ...Options) error {
 	}
 
 	df.Series = append(df.Series[:idx], df.Series[idx+1:]...)
+
+	if df.index != nil && df.index.name == seriesName {
+		df.index = nil
+	}
+
 	return nil
 }
 
@@ -471,6 +593,18 @@ func (df *DataFrame) AddSeries(s SeriesAny, colN *int, options ...Options) error
 		panic("different number of rows in series")
 	}
 
+	df.addSeries(s, colN)
+
+	return nil
+}
+
+// addSeries inserts the series at colN (or at the end if colN is nil) and caps
+// it to maxLen of the DataFrame
+func (df *DataFrame) addSeries(s SeriesAny, colN *int) {
+	if df.maxLen > 0 {
+		s.SetMaxLen(df.maxLen)
+	}
+
 	if colN == nil {
 		df.Series = append(df.Series, s)
 	} else {
@@ -478,8 +612,64 @@ func (df *DataFrame) AddSeries(s SeriesAny, colN *int, options ...Options) error
 		copy(df.Series[*colN+1:], df.Series[*colN:])
 		df.Series[*colN] = s
 	}
+}
 
-	return nil
+// SetMaxLen caps the DataFrame to n rows. Appending to the full DataFrame evicts
+// the oldest rows of all series. If the DataFrame has more than n rows, the oldest
+// rows are removed immediately. If n is 0, the DataFrame is not capped. Series
+// added later by AddSeries are capped too.
+//
+// Example:
+//
+//	df := NewDataFrame(NewSeries[float64]("bid", nil), NewSeries[float64]("ask", nil))
+//	df.SetMaxLen(1000)
+//	df.Append(map[string]any { "bid": 1.1, "ask": 1.2 })
+//
+func (df *DataFrame) SetMaxLen(n int, options ...Options) {
+	opts := DefaultOptions(options...)
+
+	defer df.events.flush()
+
+	if !opts.DontLock {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	if n < 0 {
+		panic("MaxLen must not be negative")
+	}
+
+	df.maxLen = n
+
+	evicted := 0
+	if n > 0 && df.n > n {
+		evicted = df.n - n
+		df.indexRemoving(0, evicted)
+	}
+
+	for i := range df.Series {
+		df.Series[i].SetMaxLen(n)
+	}
+
+	if evicted > 0 {
+		if df.events.active() {
+			df.events.enqueue(Event[map[string]any]{Kind: EVENT_EVICT, Count: evicted})
+		}
+
+		df.n = n
+	}
+}
+
+// MaxLen returns the maximal number of rows of the capped DataFrame or 0
+// if the DataFrame is not capped.
+func (df *DataFrame) MaxLen(options ...Options) int {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock(); defer df.lock.RUnlock()
+	}
+
+	return df.maxLen
 }
 
 // Swap is used to swap 2 values based on their row position.
@@ -494,6 +684,33 @@ func (df *DataFrame) Swap(row1, row2 int, options ...Options) {
 	for idx := range df.Series {
 		df.Series[idx].Swap(row1, row2)
 	}
+	df.invalidateIndex()
+}
+
+// reorder locks all the series and calls fn which moves rows by swap. Then
+// EVENT_SORT is queued for every series, even if fn panics. The caller
+// delivers the events by flushEvents of the series.
+func (df *DataFrame) reorder(fn func()) {
+	for _, s := range df.Series {
+		s.Lock()
+	}
+
+	defer func() {
+		for _, s := range df.Series {
+			s.sorted()
+			s.Unlock()
+		}
+	}()
+
+	fn()
+}
+
+// swap swaps values of the series without locking them and without updating
+// the index
+func (df *DataFrame) swap(row1, row2 int) {
+	for _, s := range df.Series {
+		s.swap(row1, row2)
+	}
 }
 
 // Lock will lock the Dataframe allowing you to directly manipulate
@@ -543,7 +760,8 @@ func (df *DataFrame) RUnlock(deep ...bool) {
 	df.lock.RUnlock()
 }
 
-// Copy will create a new copy of the Dataframe.
+// Copy will create a new copy of the Dataframe. Series are copied
+// by CopyAny, so they share values until they are changed.
 // It is recommended that you lock the Dataframe
 // before attempting to Copy.
 func (df *DataFrame) Copy(options ...RangeOptions) *DataFrame {
@@ -555,6 +773,8 @@ func (df *DataFrame) Copy(options ...RangeOptions) *DataFrame {
 
 	newDF := &DataFrame{
 		Series: series,
+		maxLen: df.maxLen,
+		index: df.index.copy(),
 	}
 
 	if len(series) > 0 {
@@ -572,6 +792,8 @@ func (df *DataFrame) Table(options ...TableOptions) string {
 		df.lock.RLock(); defer df.lock.RUnlock()
 	}
 
+	sOpts := Options{DontLock: opts.DontLock}
+
 	columns := map[any]bool{}
 	for _, v := range opts.Series {
 		columns[v] = true
@@ -583,19 +805,19 @@ func (df *DataFrame) Table(options ...TableOptions) string {
 	footers := []string{fmt.Sprintf("%dx%d", df.n, len(df.Series))}
 	for idx, aSeries := range df.Series {
 		if len(columns) == 0 {
-			headers = append(headers, aSeries.Name())
+			headers = append(headers, aSeries.Name(sOpts))
 			footers = append(footers, aSeries.Type())
 		} else {
 			// Check idx
 			if columns[idx] {
-				headers = append(headers, aSeries.Name())
+				headers = append(headers, aSeries.Name(sOpts))
 				footers = append(footers, aSeries.Type())
 				continue
 			}
 
 			// Check series name
-			if columns[aSeries.Name()] {
-				headers = append(headers, aSeries.Name())
+			if columns[aSeries.Name(sOpts)] {
+				headers = append(headers, aSeries.Name(sOpts))
 				footers = append(footers, aSeries.Type())
 				continue
 			}
@@ -614,17 +836,17 @@ func (df *DataFrame) Table(options ...TableOptions) string {
 
 			for idx, aSeries := range df.Series {
 				if len(columns) == 0 {
-					sVals = append(sVals, aSeries.ValueString(row))
+					sVals = append(sVals, aSeries.ValueString(row, sOpts))
 				} else {
 					// Check idx
 					if columns[idx] {
-						sVals = append(sVals, aSeries.ValueString(row))
+						sVals = append(sVals, aSeries.ValueString(row, sOpts))
 						continue
 					}
 
 					// Check series name
-					if columns[aSeries.Name()] {
-						sVals = append(sVals, aSeries.ValueString(row))
+					if columns[aSeries.Name(sOpts)] {
+						sVals = append(sVals, aSeries.ValueString(row, sOpts))
 						continue
 					}
 				}
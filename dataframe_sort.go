@@ -36,8 +36,8 @@ func (s *sorter) Less(i, j int) bool {
 	for _, key := range s.keys {
 		series := s.df.Series[key.seriesIndex]
 
-		left := series.ValueAny(i)
-		right := series.ValueAny(j)
+		left := series.ValueAny(i, dontLock)
+		right := series.ValueAny(j, dontLock)
 
 		// Check if left and right are not equal
 		if !series.IsEqualAnyFunc(left, right) {
@@ -53,11 +53,12 @@ func (s *sorter) Less(i, j int) bool {
 }
 
 func (s *sorter) Swap(i, j int) {
-	s.df.Swap(i, j, DontLock)
+	s.df.swap(i, j)
 }
 
 // Sort is used to sort the Dataframe according to different keys.
 // It will return true if sorting was completed or false when the context is canceled.
+// Subscribers of the DataFrame and of its series get EVENT_SORT.
 func (df *DataFrame) Sort(ctx context.Context, keys []SortKey, options ...SortOptions) (completed bool) {
 	if len(keys) == 0 {
 		return true
@@ -75,12 +76,23 @@ func (df *DataFrame) Sort(ctx context.Context, keys []SortKey, options ...SortOp
 
 	opts := DefaultOptions(options...)
 
+	var series []SeriesAny
+	defer func() {
+		for _, s := range series {
+			s.flushEvents()
+		}
+	}()
+
+	defer df.events.flush()
+
 	if !opts.DontLock {
 		// Default
 		df.lock.Lock()
 		defer df.lock.Unlock()
 	}
 
+	series = df.Series
+
 	// Clear seriesIndex from keys
 	defer func() {
 		for i := range keys {
@@ -111,11 +123,20 @@ func (df *DataFrame) Sort(ctx context.Context, keys []SortKey, options ...SortOp
 		ctx:  ctx,
 	}
 
-	if opts.Stable {
-		sort.Stable(s)
-	} else {
-		// Default
-		sort.Sort(s)
+	// rows are moved even if sorting is canceled
+	defer df.invalidateIndex()
+
+	df.reorder(func() {
+		if opts.Stable {
+			sort.Stable(s)
+		} else {
+			// Default
+			sort.Sort(s)
+		}
+	})
+
+	if df.events.active() {
+		df.events.enqueue(Event[map[string]any]{Kind: EVENT_SORT, Count: df.n})
 	}
 
 	return true
@@ -0,0 +1,325 @@
+package dataframe
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// EventKind is the kind of change of a series or DataFrame.
+type EventKind int
+
+const (
+	// EVENT_APPEND - rows were appended to the end
+	EVENT_APPEND EventKind = 0
+
+	// EVENT_INSERT - rows were inserted before the last row
+	EVENT_INSERT EventKind = 1
+
+	// EVENT_UPDATE - a row was updated
+	EVENT_UPDATE EventKind = 2
+
+	// EVENT_REMOVE - a row was removed
+	EVENT_REMOVE EventKind = 3
+
+	// EVENT_SORT - rows were reordered by Sort or Swap
+	EVENT_SORT EventKind = 4
+
+	// EVENT_RESET - all rows were removed
+	EVENT_RESET EventKind = 5
+
+	// EVENT_EVICT - the first rows were evicted from the capped series or DataFrame
+	EVENT_EVICT EventKind = 6
+
+	// EVENT_REPLACE - all rows were replaced by a committed transaction
+	EVENT_REPLACE EventKind = 7
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EVENT_APPEND:
+		return "append"
+	case EVENT_INSERT:
+		return "insert"
+	case EVENT_UPDATE:
+		return "update"
+	case EVENT_REMOVE:
+		return "remove"
+	case EVENT_SORT:
+		return "sort"
+	case EVENT_RESET:
+		return "reset"
+	case EVENT_EVICT:
+		return "evict"
+	case EVENT_REPLACE:
+		return "replace"
+	}
+	return "unknown"
+}
+
+// Event describes a change of Count rows starting at Row. Rows are positions
+// before the change for EVENT_REMOVE, EVENT_RESET and EVENT_EVICT, otherwise
+// positions after the change. Values are the appended, inserted, updated or
+// removed values, they are nil for EVENT_SORT, EVENT_RESET, EVENT_EVICT and
+// EVENT_REPLACE.
+// Values of DataFrame events are rows as maps of series names to values.
+type Event[T any] struct {
+	Kind       EventKind
+	Row, Count int
+	Values     []T
+}
+
+// Backpressure defines what happens when the buffer of a subscriber is full.
+type Backpressure int
+
+const (
+	// BLOCK - the change waits until the subscriber has room for the event
+	BLOCK Backpressure = 0
+
+	// DROP_NEWEST - the new event is dropped
+	DROP_NEWEST Backpressure = 1
+
+	// DROP_OLDEST - the oldest buffered event is dropped
+	DROP_OLDEST Backpressure = 2
+)
+
+// SubscribeOptions is defined as an optional parameters for Subscribe(...) function.
+//
+// Defaults:
+//	• `Buffer` - 0, the subscriber is called synchronously
+//	• `Backpressure` - BLOCK
+//
+// Properties:
+//	• `Buffer` - number of events buffered for the subscriber. If Buffer is 0, the
+//	   subscriber is called by the goroutine which made the change, otherwise it
+//	   is called by its own goroutine
+//	• `Backpressure` - what happens with new events when the buffer is full
+type SubscribeOptions struct {
+	Buffer       int
+	Backpressure Backpressure
+}
+
+// Subscription delivers change events to a subscriber. Events are delivered in
+// the order of changes after the lock of the series or DataFrame is released.
+// If the change is made with DontLock, the events are delivered before the
+// change function returns, while the caller holds the lock.
+type Subscription[T any] struct {
+	fn   func(Event[T])
+	opts SubscribeOptions
+
+	ch   chan Event[T]
+	done chan struct{}
+	once sync.Once
+
+	dropped int64
+
+	hub *hub[T]
+}
+
+// Unsubscribe stops delivering of events. Buffered events are discarded.
+func (sub *Subscription[T]) Unsubscribe() {
+	sub.once.Do(func() {
+		sub.hub.remove(sub)
+		close(sub.done)
+	})
+}
+
+// Dropped returns the number of events dropped because of the full buffer.
+func (sub *Subscription[T]) Dropped() int {
+	return int(atomic.LoadInt64(&sub.dropped))
+}
+
+func (sub *Subscription[T]) run() {
+	for {
+		select {
+		case ev := <-sub.ch:
+			sub.fn(ev)
+		case <-sub.done:
+			return
+		}
+	}
+}
+
+func (sub *Subscription[T]) send(ev Event[T]) {
+	if sub.ch == nil {
+		select {
+		case <-sub.done:
+		default:
+			sub.fn(ev)
+		}
+		return
+	}
+
+	switch sub.opts.Backpressure {
+	case DROP_NEWEST:
+		select {
+		case sub.ch <- ev:
+		case <-sub.done:
+		default:
+			atomic.AddInt64(&sub.dropped, 1)
+		}
+	case DROP_OLDEST:
+		for {
+			select {
+			case sub.ch <- ev:
+				return
+			case <-sub.done:
+				return
+			default:
+			}
+
+			select {
+			case <-sub.ch:
+				atomic.AddInt64(&sub.dropped, 1)
+			default:
+			}
+		}
+	default:
+		select {
+		case sub.ch <- ev:
+		case <-sub.done:
+		}
+	}
+}
+
+// hub queues events of changes and delivers them to subscriptions
+type hub[T any] struct {
+	mu       sync.Mutex
+	subs     []*Subscription[T]
+	queue    []Event[T]
+	flushing bool
+
+	// number of subscriptions, events are not created without subscriptions
+	n int32
+}
+
+func (h *hub[T]) subscribe(fn func(Event[T]), opts SubscribeOptions) *Subscription[T] {
+	if fn == nil {
+		panic("subscriber function must not be nil")
+	}
+
+	sub := &Subscription[T]{
+		fn:   fn,
+		opts: opts,
+		done: make(chan struct{}),
+		hub:  h,
+	}
+
+	if opts.Buffer > 0 {
+		sub.ch = make(chan Event[T], opts.Buffer)
+		go sub.run()
+	}
+
+	h.mu.Lock(); defer h.mu.Unlock()
+
+	h.subs = append(h.subs, sub)
+	atomic.StoreInt32(&h.n, int32(len(h.subs)))
+
+	return sub
+}
+
+func (h *hub[T]) remove(sub *Subscription[T]) {
+	h.mu.Lock(); defer h.mu.Unlock()
+
+	for i := range h.subs {
+		if h.subs[i] == sub {
+			h.subs = append(h.subs[:i:i], h.subs[i+1:]...)
+			break
+		}
+	}
+
+	if len(h.subs) == 0 {
+		h.queue = nil
+	}
+
+	atomic.StoreInt32(&h.n, int32(len(h.subs)))
+}
+
+// active returns true if there are subscriptions
+func (h *hub[T]) active() bool {
+	return atomic.LoadInt32(&h.n) > 0
+}
+
+// enqueue queues the event, it is called while the change is locked
+func (h *hub[T]) enqueue(ev Event[T]) {
+	h.mu.Lock(); defer h.mu.Unlock()
+	h.queue = append(h.queue, ev)
+}
+
+// flush delivers queued events. If events are delivered by another call
+// (e.g. a subscriber changes the series), the other call delivers them.
+func (h *hub[T]) flush() {
+	if !h.active() {
+		return
+	}
+
+	h.mu.Lock()
+	if h.flushing {
+		h.mu.Unlock()
+		return
+	}
+
+	h.flushing = true
+
+	// reset flushing if a subscriber panics
+	completed := false
+	defer func() {
+		if !completed {
+			h.mu.Lock()
+			h.flushing = false
+			h.mu.Unlock()
+		}
+	}()
+
+	for len(h.queue) > 0 {
+		queue := h.queue
+		subs := append([]*Subscription[T](nil), h.subs...)
+		h.queue = nil
+		h.mu.Unlock()
+
+		for _, ev := range queue {
+			for _, sub := range subs {
+				sub.send(ev)
+			}
+		}
+
+		h.mu.Lock()
+	}
+
+	// flushing is reset with the empty queue, so no event is left behind
+	h.flushing = false
+	completed = true
+	h.mu.Unlock()
+}
+
+// insertedRange returns the number of evicted rows and the row and the number
+// of count values inserted at row into n rows which remain after eviction
+func insertedRange(row, n, count, nRows int) (evicted, newRow, newCount int) {
+	e := n + count - nRows
+	if e <= 0 {
+		return 0, row, count
+	}
+
+	// first rows before the inserted values
+	e1 := e
+	if e1 > row {
+		e1 = row
+	}
+
+	// inserted values
+	e2 := e - e1
+	if e2 > count {
+		e2 = count
+	}
+
+	// rows after the inserted values
+	e3 := e - e1 - e2
+
+	return e1 + e3, row - e1, count - e2
+}
+
+func insertedKind(row, n int) EventKind {
+	if row == n {
+		return EVENT_APPEND
+	}
+	return EVENT_INSERT
+}
@@ -137,11 +137,12 @@ func FilterDataFrame(ctx context.Context, df *DataFrame, fn FilterDataFrameFn, o
 		// Create all series
 		seriess := []SeriesAny{}
 		for _, s := range df.Series {
-			seriess = append(seriess, s.cloneAsEmpty(len(transfer), len(transfer)))
+			seriess = append(seriess, s.emptyCopy(len(transfer)))
 		}
 
 		// Create a new dataframe
 		ndf := NewDataFrame(seriess...)
+		ndf.index = df.index.copy()
 
 		for _, rowToTransfer := range transfer {
 			vals := df.Row(rowToTransfer, dontLock)
@@ -0,0 +1,199 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrFrozen is returned by mutators of FrozenDataFrame.
+var ErrFrozen = errors.New("dataframe is frozen")
+
+// FrozenDataFrame is an immutable snapshot of a DataFrame created by Freeze.
+// Its values never change, so reads do not lock and it can be shared by any
+// number of goroutines. Mutators return ErrFrozen. A mutable DataFrame is
+// created by Thaw.
+type FrozenDataFrame struct {
+	df *DataFrame
+
+	// columns of series by their names
+	columns map[string]int
+}
+
+// Freeze returns an immutable snapshot of the DataFrame. Series are copied
+// by CopyAny, so values are shared until the DataFrame changes them.
+//
+// Example:
+//
+//	ref := df.Freeze()
+//
+//	// in any number of goroutines
+//	price := ref.Value(-1, "close")
+//
+func (df *DataFrame) Freeze(options ...Options) *FrozenDataFrame {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock(); defer df.lock.RUnlock()
+	}
+
+	series := make([]SeriesAny, 0, len(df.Series))
+	for _, s := range df.Series {
+		s.RLock()
+		series = append(series, s.CopyAny())
+		s.RUnlock()
+	}
+
+	columns := make(map[string]int, len(series))
+	for i, s := range series {
+		columns[s.Name(dontLock)] = i
+	}
+
+	return &FrozenDataFrame{
+		df: &DataFrame{
+			Series: series,
+			n:      df.n,
+			maxLen: df.maxLen,
+			index:  df.index.copy(),
+		},
+		columns: columns,
+	}
+}
+
+// Thaw returns a mutable DataFrame with values of the snapshot. Series are
+// copied by CopyAny, so values are shared with the snapshot until they are
+// changed and Thaw takes O(1) per series.
+func (f *FrozenDataFrame) Thaw() *DataFrame {
+	series := make([]SeriesAny, 0, len(f.df.Series))
+	for _, s := range f.df.Series {
+		series = append(series, s.CopyAny())
+	}
+
+	return &DataFrame{
+		Series: series,
+		n:      f.df.n,
+		maxLen: f.df.maxLen,
+		index:  f.df.index.copy(),
+	}
+}
+
+// NRows returns the number of rows of data.
+func (f *FrozenDataFrame) NRows() int {
+	return f.df.n
+}
+
+// Names will return a list of all the series names.
+func (f *FrozenDataFrame) Names() []string {
+	return f.df.Names(dontLock)
+}
+
+// NameToColumn returns the index of the series based on the name.
+func (f *FrozenDataFrame) NameToColumn(seriesName string) (int, error) {
+	if col, ok := f.columns[seriesName]; ok {
+		return col, nil
+	}
+	return -1, errors.New("no series contains name: " + seriesName)
+}
+
+// series returns the series of the column or name
+func (f *FrozenDataFrame) series(col any) SeriesAny {
+	if name, ok := col.(string); ok {
+		c, err := f.NameToColumn(name)
+		if err != nil {
+			panic(err)
+		}
+		return f.df.Series[c]
+	}
+	return f.df.Series[col.(int)]
+}
+
+// Series returns a read-only view of the series. col can be the name of the
+// series or the column number.
+func (f *FrozenDataFrame) Series(col any) SeriesReader {
+	s := f.series(col)
+	return &seriesView{parent: s, window: s}
+}
+
+// Value returns the value of a particular row of the series. col can be the
+// name of the series or the column number. Negative rows are indexed from the end.
+func (f *FrozenDataFrame) Value(row int, col any) any {
+	if row < 0 {
+		row = f.df.n + row
+	}
+	return f.series(col).ValueAny(row, dontLock)
+}
+
+// Row returns the series' values for a particular row.
+func (f *FrozenDataFrame) Row(row int) map[string]any {
+	return f.df.Row(row, dontLock)
+}
+
+// Iterator will return a iterator that can be used to iterate through all the rows.
+func (f *FrozenDataFrame) Iterator(options ...IteratorOptions) Iterator[map[string]any] {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return f.df.Iterator(opts)
+}
+
+// Table will produce the snapshot in a table.
+func (f *FrozenDataFrame) Table(options ...TableOptions) string {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return f.df.Table(opts)
+}
+
+// String implements the fmt.Stringer interface.
+func (f *FrozenDataFrame) String() string {
+	return f.df.String()
+}
+
+// Prepend returns ErrFrozen.
+func (f *FrozenDataFrame) Prepend(vals any) error {
+	return ErrFrozen
+}
+
+// Append returns ErrFrozen.
+func (f *FrozenDataFrame) Append(vals any) error {
+	return ErrFrozen
+}
+
+// Insert returns ErrFrozen.
+func (f *FrozenDataFrame) Insert(row int, vals any) error {
+	return ErrFrozen
+}
+
+// Remove returns ErrFrozen.
+func (f *FrozenDataFrame) Remove(row int) error {
+	return ErrFrozen
+}
+
+// Update returns ErrFrozen.
+func (f *FrozenDataFrame) Update(row int, col any, val any) error {
+	return ErrFrozen
+}
+
+// UpdateRow returns ErrFrozen.
+func (f *FrozenDataFrame) UpdateRow(row int, vals any) error {
+	return ErrFrozen
+}
+
+// Swap returns ErrFrozen.
+func (f *FrozenDataFrame) Swap(row1, row2 int) error {
+	return ErrFrozen
+}
+
+// Sort returns ErrFrozen.
+func (f *FrozenDataFrame) Sort(ctx context.Context, keys []SortKey, options ...SortOptions) error {
+	return ErrFrozen
+}
+
+// AddSeries returns ErrFrozen.
+func (f *FrozenDataFrame) AddSeries(s SeriesAny, colN *int) error {
+	return ErrFrozen
+}
+
+// RemoveSeries returns ErrFrozen.
+func (f *FrozenDataFrame) RemoveSeries(seriesName string) error {
+	return ErrFrozen
+}
@@ -0,0 +1,502 @@
+package dataframe
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// IndexType defines how the index of a DataFrame is organized.
+type IndexType int
+
+const (
+	// HASH index finds keys in O(1). It is used by default.
+	HASH IndexType = 0
+
+	// SORTED index keeps positions of rows in order of keys and finds keys in O(log n).
+	// It requires IsLessThanFunc of the key series and it is efficient
+	// for LocRange.
+	SORTED IndexType = 1
+)
+
+// IndexOptions is defined as an optional parameters
+// for SetIndex(...).
+//
+// Defaults:
+// 		IndexOptions { Type: HASH, DontLock: false }
+//
+// Properties:
+//	• `Type` - HASH or SORTED index
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type IndexOptions struct {
+	Type IndexType
+	DontLock bool
+}
+
+// index maps keys of the key series to rows. It is maintained by the
+// DataFrame: appended rows are added incrementally, other modifications
+// invalidate the index and it is rebuilt on the next lookup.
+type index struct {
+	name string
+	typ  IndexType
+
+	mu    sync.Mutex
+	valid bool
+
+	// rows are stored as ids (row + offset), so removing the first rows
+	// does not change ids of other rows
+	offset int
+
+	// HASH
+	keys *keyIndex
+	rows [][]int
+
+	// number of keys without rows
+	stale int
+
+	// SORTED
+	sorted []int
+}
+
+// SetIndex sets series seriesName as the key series of the DataFrame.
+// The index is used by Loc, LocRows and LocRange. The index is maintained
+// through Insert, Remove, Update, Sort, Filter, etc. Direct modification
+// of the key series is not tracked, call SetIndex again after such changes.
+//
+// Example:
+//
+//	df.SetIndex("time", IndexOptions { Type: SORTED })
+//	bar := df.Loc(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+//
+func (df *DataFrame) SetIndex(seriesName string, options ...IndexOptions) error {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	col, err := df.NameToColumn(seriesName, dontLock)
+	if err != nil {
+		return errors.New(err.Error() + ": " + seriesName)
+	}
+
+	if opts.Type == SORTED && !df.Series[col].hasIsLessThanFunc() {
+		return errors.New("sorted index requires IsLessThanFunc: " + seriesName)
+	}
+
+	df.index = &index{name: seriesName, typ: opts.Type}
+	return nil
+}
+
+// ResetIndex removes the index of the DataFrame.
+func (df *DataFrame) ResetIndex(options ...Options) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	df.index = nil
+}
+
+// IndexName returns the name of the key series. Empty string is returned
+// if the index is not set.
+func (df *DataFrame) IndexName(options ...Options) string {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	if df.index == nil {
+		return ""
+	}
+	return df.index.name
+}
+
+// LocRows returns all the rows containing key in the key series. Rows are
+// in ascending order. It panics if the index is not set.
+func (df *DataFrame) LocRows(key any, options ...Options) []int {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	return df.locRows(key)
+}
+
+// Loc returns the series' values for the first row containing key in the
+// key series. Nil is returned if there is no such row. It panics if the
+// index is not set.
+func (df *DataFrame) Loc(key any, options ...Options) map[string]any {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	rows := df.locRows(key)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return df.Row(rows[0], dontLock)
+}
+
+// LocRange returns a new DataFrame containing rows whose keys are between from
+// and to (both inclusive). Rows are ordered by keys. Key series must have
+// IsLessThanFunc set. It panics if the index is not set. SORTED index finds
+// the range in O(log n), HASH index scans and sorts all the rows.
+//
+// Example:
+//
+//	df.SetIndex("time", IndexOptions { Type: SORTED })
+//	day := df.LocRange(from, from.Add(24 * time.Hour - time.Nanosecond))
+//
+func (df *DataFrame) LocRange(from, to any, options ...Options) *DataFrame {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	idx, s := df.validIndex()
+
+	var rows []int
+
+	if idx.typ == SORTED {
+		idx.mu.Lock()
+		start := sort.Search(len(idx.sorted), func(i int) bool {
+			return !s.IsLessThanAnyFunc(idx.key(s, i), from)
+		})
+		end := sort.Search(len(idx.sorted), func(i int) bool {
+			return s.IsLessThanAnyFunc(to, idx.key(s, i))
+		})
+		for i := start; i < end; i++ {
+			rows = append(rows, idx.sorted[i] - idx.offset)
+		}
+		idx.mu.Unlock()
+	} else {
+		for row := 0; row < df.n; row++ {
+			v := s.ValueAny(row, dontLock)
+			if !s.IsLessThanAnyFunc(v, from) && !s.IsLessThanAnyFunc(to, v) {
+				rows = append(rows, row)
+			}
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			return s.IsLessThanAnyFunc(s.ValueAny(rows[i], dontLock), s.ValueAny(rows[j], dontLock))
+		})
+	}
+
+	ndf := df.emptyCopy(len(rows))
+	for _, row := range rows {
+		for i, s := range df.Series {
+			ndf.Series[i].AppendAny(s.ValueAny(row, dontLock), dontLock)
+		}
+	}
+	ndf.n = len(rows)
+
+	return ndf
+}
+
+func (df *DataFrame) locRows(key any) []int {
+	idx, s := df.validIndex()
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if idx.typ == SORTED {
+		start := sort.Search(len(idx.sorted), func(i int) bool {
+			return !s.IsLessThanAnyFunc(idx.key(s, i), key)
+		})
+
+		rows := []int{}
+		for i := start; i < len(idx.sorted) && s.IsEqualAnyFunc(idx.key(s, i), key); i++ {
+			rows = append(rows, idx.sorted[i] - idx.offset)
+		}
+		sort.Ints(rows)
+		return rows
+	}
+
+	id, ok := idx.keys.find(key)
+	if !ok {
+		return []int{}
+	}
+	rows := make([]int, 0, len(idx.rows[id]))
+	for _, id := range idx.rows[id] {
+		rows = append(rows, id - idx.offset)
+	}
+	return rows
+}
+
+// validIndex returns the index and the key series. The index is rebuilt
+// if it was invalidated.
+func (df *DataFrame) validIndex() (*index, SeriesAny) {
+	idx := df.index
+	if idx == nil {
+		panic(errors.New("index is not set"))
+	}
+
+	s := df.Series[df.MustNameToColumn(idx.name, dontLock)]
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if !idx.valid {
+		idx.keys, idx.rows, idx.sorted = nil, nil, nil
+		idx.offset, idx.stale = 0, 0
+
+		if idx.typ == SORTED {
+			idx.sorted = make([]int, df.n)
+			for row := range idx.sorted {
+				idx.sorted[row] = row
+			}
+
+			// stable sort keeps rows with equal keys in order of rows
+			sort.SliceStable(idx.sorted, func(i, j int) bool {
+				return s.IsLessThanAnyFunc(s.ValueAny(idx.sorted[i], dontLock), s.ValueAny(idx.sorted[j], dontLock))
+			})
+		} else {
+			idx.keys = newKeyIndex(s.IsEqualAnyFunc)
+			idx.add(s, 0, df.n)
+		}
+
+		idx.valid = true
+	}
+
+	return idx, s
+}
+
+// add adds rows from start to end (exclusive) of the key series to the index.
+// It is used for rows appended to a valid SORTED index, the whole SORTED index
+// is built by sorting in validIndex.
+func (idx *index) add(s SeriesAny, start, end int) {
+	for row := start; row < end; row++ {
+		idx.insertRow(s, row, s.ValueAny(row, dontLock))
+	}
+}
+
+// key returns the key of i-th row of the SORTED index
+func (idx *index) key(s SeriesAny, i int) any {
+	return s.ValueAny(idx.sorted[i] - idx.offset, dontLock)
+}
+
+// search returns position of the row with key v in the SORTED index. Rows
+// with equal keys are in order of rows.
+func (idx *index) search(s SeriesAny, v any, id int) int {
+	return sort.Search(len(idx.sorted), func(i int) bool {
+		k := idx.key(s, i)
+		if s.IsLessThanAnyFunc(k, v) {
+			return false
+		}
+		return s.IsLessThanAnyFunc(v, k) || idx.sorted[i] >= id
+	})
+}
+
+// insertRow adds row with key v to the index
+func (idx *index) insertRow(s SeriesAny, row int, v any) {
+	id := row + idx.offset
+
+	if idx.typ == SORTED {
+		pos := idx.search(s, v, id)
+		idx.sorted = append(idx.sorted, 0)
+		copy(idx.sorted[pos+1:], idx.sorted[pos:])
+		idx.sorted[pos] = id
+		return
+	}
+
+	kid, added := idx.keys.add(v)
+	if added {
+		idx.rows = append(idx.rows, []int{})
+	} else if len(idx.rows[kid]) == 0 {
+		idx.stale--
+	}
+
+	rows := idx.rows[kid]
+	pos := sort.SearchInts(rows, id)
+	rows = append(rows, 0)
+	copy(rows[pos+1:], rows[pos:])
+	rows[pos] = id
+	idx.rows[kid] = rows
+}
+
+// removeRow removes row with key v from the index. The index is invalidated
+// if the row is not found.
+func (idx *index) removeRow(s SeriesAny, row int, v any) {
+	id := row + idx.offset
+
+	if idx.typ == SORTED {
+		pos := idx.search(s, v, id)
+		if pos == len(idx.sorted) || idx.sorted[pos] != id {
+			idx.valid = false
+			return
+		}
+		idx.sorted = append(idx.sorted[:pos], idx.sorted[pos+1:]...)
+		return
+	}
+
+	kid, ok := idx.keys.find(v)
+	if !ok {
+		idx.valid = false
+		return
+	}
+
+	rows := idx.rows[kid]
+	pos := sort.SearchInts(rows, id)
+	if pos == len(rows) || rows[pos] != id {
+		idx.valid = false
+		return
+	}
+
+	if pos == 0 {
+		rows = rows[1:]
+	} else {
+		rows = append(rows[:pos], rows[pos+1:]...)
+	}
+	idx.rows[kid] = rows
+
+	if len(rows) == 0 {
+		// keys of removed rows are kept, so the index is rebuilt
+		// when they prevail
+		idx.stale++
+		if idx.stale > len(idx.rows) / 2 {
+			idx.valid = false
+		}
+	}
+}
+
+// keySeries returns the key series of the valid index. Nil is returned and
+// the index is invalidated if the key series does not exist.
+func (df *DataFrame) keySeries(idx *index) SeriesAny {
+	col, err := df.NameToColumn(idx.name, dontLock)
+	if err != nil {
+		idx.valid = false
+		return nil
+	}
+	return df.Series[col]
+}
+
+// indexInserted updates the index after rows were inserted at row into n
+// rows. Rows appended at the end are added incrementally, otherwise the index
+// is invalidated. Rows evicted from a capped DataFrame must be removed by
+// indexRemoving before they are evicted.
+func (df *DataFrame) indexInserted(row, n, evicted int, completed bool) {
+	idx := df.index
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if !idx.valid {
+		return
+	}
+
+	if !completed || row != n {
+		idx.valid = false
+		return
+	}
+
+	if s := df.keySeries(idx); s != nil {
+		idx.add(s, n - evicted, df.n)
+	}
+}
+
+// indexRemoving updates the index before count rows from row are removed.
+// The first and the last rows are removed incrementally, removing other
+// rows invalidates the index.
+func (df *DataFrame) indexRemoving(row, count int) {
+	idx := df.index
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if !idx.valid {
+		return
+	}
+
+	if row != 0 && row + count != df.n {
+		idx.valid = false
+		return
+	}
+
+	s := df.keySeries(idx)
+
+	for r := row; r < row + count && idx.valid; r++ {
+		idx.removeRow(s, r, s.ValueAny(r, dontLock))
+	}
+
+	if row == 0 {
+		idx.offset += count
+	}
+}
+
+// indexUpdating removes the row from the index before its key is updated
+func (df *DataFrame) indexUpdating(row int) {
+	idx := df.index
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if idx.valid {
+		if s := df.keySeries(idx); s != nil {
+			idx.removeRow(s, row, s.ValueAny(row, dontLock))
+		}
+	}
+}
+
+// indexUpdated adds the row to the index after its key was updated
+func (df *DataFrame) indexUpdated(row int) {
+	idx := df.index
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock(); defer idx.mu.Unlock()
+
+	if idx.valid {
+		if s := df.keySeries(idx); s != nil {
+			idx.insertRow(s, row, s.ValueAny(row, dontLock))
+		}
+	}
+}
+
+// invalidateIndex marks the index to be rebuilt on the next lookup
+func (df *DataFrame) invalidateIndex() {
+	idx := df.index
+	if idx == nil {
+		return
+	}
+
+	idx.mu.Lock()
+	idx.valid = false
+	idx.mu.Unlock()
+}
+
+// copy returns index definition without data
+func (idx *index) copy() *index {
+	if idx == nil {
+		return nil
+	}
+	return &index{name: idx.name, typ: idx.typ}
+}
+
+// emptyCopy creates DataFrame with the same series without values. The index
+// definition is kept.
+func (df *DataFrame) emptyCopy(capacity int) *DataFrame {
+	series := make([]SeriesAny, 0, len(df.Series))
+	for _, s := range df.Series {
+		series = append(series, s.emptyCopy(capacity))
+	}
+
+	return &DataFrame{Series: series, index: df.index.copy()}
+}
@@ -0,0 +1,147 @@
+package dataframe
+
+import (
+	"reflect"
+	"time"
+)
+
+type nanKey struct{}
+
+type timeKey struct {
+	sec  int64
+	nsec int
+}
+
+type ptrKey struct {
+	key any
+}
+
+// HashKeyer is implemented by values which are equal even if they differ in their
+// fields (e.g. decimals 1.0 and 1.00). HashKey returns the same comparable key for
+// equal values, it is used to group values by ValueCounts, NUnique, indexes, etc.
+type HashKeyer interface {
+	HashKey() any
+}
+
+// hashKey returns key which can be used in map to group value v. Keys of
+// equal values are equal: NaN is equal to NaN, times are compared by instant
+// pointers by pointed values and HashKeyer values by their HashKey. False is
+// returned if v can't be hashed.
+func hashKey(v any) (any, bool) {
+	switch t := v.(type) {
+	case nil:
+		return nil, true
+	case float64:
+		if t != t {
+			return nanKey{}, true
+		}
+		return t, true
+	case float32:
+		if t != t {
+			return nanKey{}, true
+		}
+		return t, true
+	case int, int64, int32, int16, int8, uint, uint64, uint32, uint16, uint8, string, bool:
+		return t, true
+	case time.Time:
+		return timeKey{t.Unix(), t.Nanosecond()}, true
+	case HashKeyer:
+		return t.HashKey(), true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return ptrKey{}, true
+		}
+		key, ok := hashKey(rv.Elem().Interface())
+		return ptrKey{key}, ok
+	}
+
+	if !isHashable(rv.Type()) {
+		return nil, false
+	}
+
+	return v, true
+}
+
+// isHashable returns true if values of type t can be used as map keys
+// without panic
+func isHashable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Array:
+		return isHashable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isHashable(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return t.Comparable()
+}
+
+// keyIndex assigns ids to distinct values in order of their first appearance.
+// Hashable values are found in O(1), other values are compared by eq.
+type keyIndex struct {
+	eq       CompareFn[any]
+	hashed   map[any]int
+	unhashed []int
+	keys     []any
+}
+
+func newKeyIndex(eq CompareFn[any]) *keyIndex {
+	return &keyIndex{eq: eq, hashed: map[any]int{}}
+}
+
+// find returns id of value v
+func (k *keyIndex) find(v any) (int, bool) {
+	if key, ok := hashKey(v); ok {
+		id, ok := k.hashed[key]
+		return id, ok
+	}
+
+	for _, id := range k.unhashed {
+		if k.eq(k.keys[id], v) {
+			return id, true
+		}
+	}
+	return -1, false
+}
+
+// add returns id of value v, new id is created if v is not present yet.
+// The second value is true if v was added.
+func (k *keyIndex) add(v any) (int, bool) {
+	key, hashable := hashKey(v)
+
+	if hashable {
+		if id, ok := k.hashed[key]; ok {
+			return id, false
+		}
+	} else {
+		for _, id := range k.unhashed {
+			if k.eq(k.keys[id], v) {
+				return id, false
+			}
+		}
+	}
+
+	id := len(k.keys)
+	k.keys = append(k.keys, v)
+
+	if hashable {
+		k.hashed[key] = id
+	} else {
+		k.unhashed = append(k.unhashed, id)
+	}
+
+	return id, true
+}
+
+// len returns the number of distinct values
+func (k *keyIndex) len() int {
+	return len(k.keys)
+}
@@ -0,0 +1,473 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// QuantileMethod defines how a quantile is computed when it lies
+// between two values.
+type QuantileMethod int
+
+const (
+	// LINEAR interpolates linearly between the lower and the higher value.
+	LINEAR QuantileMethod = 0
+
+	// LOWER takes the lower value.
+	LOWER QuantileMethod = 1
+
+	// HIGHER takes the higher value.
+	HIGHER QuantileMethod = 2
+
+	// NEAREST takes the nearest value, the one at even position if both are equally near.
+	NEAREST QuantileMethod = 3
+
+	// MIDPOINT takes the mean of the lower and the higher value.
+	MIDPOINT QuantileMethod = 4
+)
+
+// RankMethod defines how equal values are ranked.
+type RankMethod int
+
+const (
+	// AVERAGE ranks equal values by the average of their ranks.
+	AVERAGE RankMethod = 0
+
+	// MIN ranks equal values by the lowest of their ranks.
+	MIN RankMethod = 1
+
+	// MAX ranks equal values by the highest of their ranks.
+	MAX RankMethod = 2
+
+	// DENSE is like MIN, but ranks of groups of equal values increase by 1.
+	DENSE RankMethod = 3
+
+	// FIRST ranks equal values in order of rows.
+	FIRST RankMethod = 4
+)
+
+// QuantileOptions is defined as an optional parameters
+// for QuantileWithOptions(...).
+//
+// Defaults:
+// 		QuantileOptions { Method: LINEAR, DontLock: false }
+//
+// Properties:
+//	• `Method` - interpolation method
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type QuantileOptions struct {
+	Method QuantileMethod
+	DontLock bool
+}
+
+// RankOptions is defined as an optional parameters
+// for Rank(...).
+//
+// Defaults:
+// 		RankOptions { Method: AVERAGE, Pct: false, Desc: false, DontLock: false }
+//
+// Properties:
+//	• `Method` - ranking of equal values
+//	• `Pct` - if true, ranks are divided by the number of ranked values (by the number
+//	   of distinct values for DENSE)
+//	• `Desc` - if true, the highest value has rank 1
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type RankOptions struct {
+	Method RankMethod
+	Pct, Desc, DontLock bool
+}
+
+// CutOptions is defined as an optional parameters
+// for Cut(...) and QCut(...).
+//
+// Defaults:
+// 		CutOptions { Labels: nil, Left: false, IncludeLowest: false, DontLock: false }
+//
+// Properties:
+//	• `Labels` - labels of bins. If not set, bins are labeled by their intervals, e.g. "(1, 2]"
+//	• `Left` - if true, bins are closed on the left side [a, b) instead of the right one (a, b]
+//	• `IncludeLowest` - if true, the first bin (the last one when Left is set) is closed on
+//	   both sides
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type CutOptions struct {
+	Labels []string
+	Left, IncludeLowest, DontLock bool
+}
+
+// Quantile returns q-quantiles (0 <= q <= 1) of the series computed by linear
+// interpolation. NaN values are skipped. If the series contains no values, NaNs
+// are returned. Quantiles of non-numeric series are returned by QuantileValues.
+//
+// Example:
+//
+//	thresholds := Quantile(returns, 0.05, 0.5, 0.95)
+//
+func Quantile[T Number](s *Series[T], q ...float64) []float64 {
+	return QuantileWithOptions(s, q)
+}
+
+// QuantileWithOptions returns q-quantiles (0 <= q <= 1) of the series. See Quantile.
+//
+// Example:
+//
+//	median := QuantileWithOptions(prices, []float64 { 0.5 }, QuantileOptions { Method: LOWER })[0]
+//
+func QuantileWithOptions[T Number](s *Series[T], q []float64, options ...QuantileOptions) []float64 {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	sorted := sortedFloats(s.Values)
+
+	out := make([]float64, len(q))
+	for i, v := range q {
+		if v < 0 || v > 1 {
+			panic("quantile must be in range [0, 1]")
+		}
+		out[i] = quantileSorted(sorted, v, opts.Method)
+	}
+
+	return out
+}
+
+// QuantileValues returns q-quantiles (0 <= q <= 1) of the series ordered by its
+// IsLessThanFunc, so it can be used for non-numeric series (e.g. time.Time or string).
+// Numeric series without IsLessThanFunc are ordered naturally.
+// Quantiles are values of the series, so only LOWER, HIGHER and NEAREST methods can
+// be used, other methods panic. Null values (NaN, nil) are skipped. If the series
+// contains no values, zero values are returned.
+//
+// Example:
+//
+//	median := QuantileValues(times, []float64 { 0.5 }, QuantileOptions { Method: LOWER })[0]
+//
+func QuantileValues[T any](s *Series[T], q []float64, options ...QuantileOptions) []T {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	less := s.lessFunc()
+
+	if opts.Method != LOWER && opts.Method != HIGHER && opts.Method != NEAREST {
+		panic(fmt.Sprintf("quantile method can not be used for ordered values: %d", opts.Method))
+	}
+
+	sorted := make([]T, 0, len(s.Values))
+	for _, v := range s.Values {
+		if !isNull(v) {
+			sorted = append(sorted, v)
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	})
+
+	out := make([]T, len(q))
+	for i, v := range q {
+		if v < 0 || v > 1 {
+			panic("quantile must be in range [0, 1]")
+		}
+		if len(sorted) > 0 {
+			out[i] = sorted[quantileRow(len(sorted), v, opts.Method)]
+		}
+	}
+
+	return out
+}
+
+// lessFunc returns IsLessThanFunc of the series or the natural order of numeric
+// series. It panics if the series is not ordered.
+func (s *Series[T]) lessFunc() CompareFn[T] {
+	if s.isLessThanFunc != nil {
+		return s.isLessThanFunc
+	}
+
+	if less := numericLess[T](); less != nil {
+		return less
+	}
+
+	panic("IsLessThanFunc is not set")
+}
+
+// quantileRow returns position of q-quantile of n sorted values for methods
+// which take one of the values
+func quantileRow(n int, q float64, method QuantileMethod) int {
+	pos := q * float64(n-1)
+
+	switch method {
+	case LOWER:
+		return int(math.Floor(pos))
+	case HIGHER:
+		return int(math.Ceil(pos))
+	}
+	return int(math.RoundToEven(pos))
+}
+
+// sortedFloats returns sorted values converted to float64 without NaNs
+func sortedFloats[T Number](vals []T) []float64 {
+	sorted := make([]float64, 0, len(vals))
+	for _, v := range vals {
+		if v == v {
+			sorted = append(sorted, float64(v))
+		}
+	}
+	sort.Float64s(sorted)
+	return sorted
+}
+
+// quantileSorted returns q-quantile of sorted values
+func quantileSorted(sorted []float64, q float64, method QuantileMethod) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+
+	pos := q * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+
+	switch method {
+	case LINEAR:
+		return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+	case LOWER, HIGHER, NEAREST:
+		return sorted[quantileRow(len(sorted), q, method)]
+	case MIDPOINT:
+		return (sorted[lo] + sorted[hi]) / 2
+	}
+
+	panic(fmt.Sprintf("unknown quantile method: %d", method))
+}
+
+// Rank returns a new series with ranks (starting with 1) of values ordered by
+// IsLessThanFunc of the series. Numeric series without IsLessThanFunc are ordered
+// naturally, other series panic without it. Values are equal if neither is less
+// than the other.
+// Null values (NaN, nil) are not ranked and their rank is NaN.
+//
+// Example:
+//
+//	rank := Rank(momentum, RankOptions { Pct: true })
+//
+func Rank[T any](s *Series[T], options ...RankOptions) *Series[float64] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	asc := s.lessFunc()
+
+	less := asc
+	if opts.Desc {
+		less = func(a, b T) bool { return asc(b, a) }
+	}
+
+	rows := make([]int, 0, len(s.Values))
+	for row, v := range s.Values {
+		if !isNull(v) {
+			rows = append(rows, row)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return less(s.Values[rows[i]], s.Values[rows[j]])
+	})
+
+	ranks := make([]float64, len(s.Values))
+	for i := range ranks {
+		ranks[i] = math.NaN()
+	}
+
+	var dense int
+
+	for start := 0; start < len(rows); {
+		// group of equal values
+		end := start + 1
+		for end < len(rows) && !less(s.Values[rows[start]], s.Values[rows[end]]) {
+			end++
+		}
+		dense++
+
+		for i := start; i < end; i++ {
+			var r float64
+
+			switch opts.Method {
+			case AVERAGE:
+				r = float64(start+end+1) / 2
+			case MIN:
+				r = float64(start + 1)
+			case MAX:
+				r = float64(end)
+			case DENSE:
+				r = float64(dense)
+			case FIRST:
+				r = float64(i + 1)
+			default:
+				panic(fmt.Sprintf("unknown rank method: %d", opts.Method))
+			}
+
+			ranks[rows[i]] = r
+		}
+
+		start = end
+	}
+
+	if opts.Pct {
+		n := float64(len(rows))
+		if opts.Method == DENSE {
+			n = float64(dense)
+		}
+		for i := range ranks {
+			ranks[i] /= n
+		}
+	}
+
+	return NewSeries(s.name, nil, ranks...)
+}
+
+// Cut bins values of the series into intervals defined by increasing bin edges.
+// n edges define n-1 bins, bins are closed on the right side by default. The result
+// is an ordered categorical series with labels as categories. Values which are not
+// in any bin and NaN values are null.
+//
+// Example:
+//
+//	size, err := Cut(volume, []float64 { 0, 100, 1000, math.Inf(1) }, CutOptions { Labels: []string { "S", "M", "L" } })
+//
+func Cut[T Number](s *Series[T], bins []float64, options ...CutOptions) (*Categorical, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	return cut(s, bins, opts)
+}
+
+// QCut bins values of the series into intervals defined by q-quantiles of the
+// series (see Quantile). Bin edges must be unique. Bins are closed on the
+// right side and the lowest value is included in the first bin.
+//
+// Example:
+//
+//	// quartiles
+//	quartile, err := QCut(returns, []float64 { 0, .25, .5, .75, 1 }, CutOptions { Labels: []string { "Q1", "Q2", "Q3", "Q4" } })
+//
+func QCut[T Number](s *Series[T], q []float64, options ...CutOptions) (*Categorical, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	bins := QuantileWithOptions(s, q, QuantileOptions{DontLock: true})
+
+	opts.Left, opts.IncludeLowest = false, true
+
+	return cut(s, bins, opts)
+}
+
+func cut[T Number](s *Series[T], bins []float64, opts CutOptions) (*Categorical, error) {
+	if len(bins) < 2 {
+		return nil, errors.New("at least 2 bin edges are required")
+	}
+
+	for i := 1; i < len(bins); i++ {
+		if !(bins[i-1] < bins[i]) {
+			return nil, fmt.Errorf("bin edges must be increasing: %v", bins)
+		}
+	}
+
+	labels := opts.Labels
+	if labels == nil {
+		labels = binLabels(bins, opts.Left, opts.IncludeLowest)
+	} else if len(labels) != len(bins)-1 {
+		return nil, fmt.Errorf("expected %d labels: %d", len(bins)-1, len(labels))
+	}
+
+	unique := map[string]bool{}
+	for _, label := range labels {
+		if unique[label] {
+			return nil, errors.New("labels must be unique: " + label)
+		}
+		unique[label] = true
+	}
+
+	c := NewCategorical(s.name, &CategoricalInit{
+		Capacity:   len(s.Values),
+		Categories: labels,
+		Ordered:    true,
+	})
+
+	// codes of categories are positions of labels
+	codes := make([]int32, len(s.Values))
+	for i, v := range s.Values {
+		codes[i] = int32(binOf(float64(v), bins, opts.Left, opts.IncludeLowest))
+	}
+	c.appendCodes(codes)
+
+	return c, nil
+}
+
+// binOf returns bin of value v or -1 if v is not in any bin
+func binOf(v float64, bins []float64, left, includeLowest bool) int {
+	if v != v {
+		return -1
+	}
+
+	last := len(bins) - 1
+
+	if left {
+		// first edge greater than v
+		i := sort.SearchFloat64s(bins, math.Nextafter(v, math.Inf(1)))
+		switch {
+		case i > 0 && i <= last:
+			return i - 1
+		case includeLowest && v == bins[last]:
+			return last - 1
+		}
+		return -1
+	}
+
+	// first edge greater or equal to v
+	i := sort.SearchFloat64s(bins, v)
+	switch {
+	case i > 0 && i <= last:
+		return i - 1
+	case includeLowest && v == bins[0]:
+		return 0
+	}
+	return -1
+}
+
+// binLabels returns interval notation of bins
+func binLabels(bins []float64, left, includeLowest bool) []string {
+	labels := make([]string, len(bins)-1)
+
+	for i := range labels {
+		lo, hi := "(", "]"
+		if left {
+			lo, hi = "[", ")"
+		}
+
+		if includeLowest {
+			if !left && i == 0 {
+				lo = "["
+			}
+			if left && i == len(labels)-1 {
+				hi = "]"
+			}
+		}
+
+		labels[i] = lo + strconv.FormatFloat(bins[i], 'g', -1, 64) + ", " + strconv.FormatFloat(bins[i+1], 'g', -1, 64) + hi
+	}
+
+	return labels
+}
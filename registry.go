@@ -0,0 +1,182 @@
+package dataframe
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// TypeOptions defines defaults which are set to every series created
+// from the registered type by NewSeriesFromType.
+//
+// Properties:
+//	• `IsEqualFunc` - function set by SetIsEqualFunc, IsEqualDefaultFunc if nil
+//	• `IsLessThanFunc` - function set by SetIsLessThanFunc
+//	• `ValueFormatter` - function set by SetValueToStringFormatter, DefaultValueFormatter if nil
+type TypeOptions[T any] struct {
+	IsEqualFunc, IsLessThanFunc CompareFn[T]
+	ValueFormatter ValueToStringFormatter
+}
+
+// SeriesFactory creates empty series of the registered type.
+type SeriesFactory func(name string, init *SeriesInit) SeriesAny
+
+var registry = struct {
+	sync.RWMutex
+	factories map[string]SeriesFactory
+}{
+	factories: map[string]SeriesFactory{},
+}
+
+// RegisterType registers type T, so series of type T can be created by
+// its type name (as returned by Series[T].Type()). Registering type
+// which is already registered replaces the previous registration.
+// The function returns the type name.
+//
+// Example:
+//
+//	type Dog struct {
+//		name string
+//	}
+//
+//	dataframe.RegisterType(dataframe.TypeOptions[Dog] {
+//		IsLessThanFunc: func(a, b Dog) bool { return a.name < b.name },
+//	})
+//
+//	s, err := dataframe.NewSeriesFromType("main.Dog", "dogs", nil)
+//
+func RegisterType[T any](options ...TypeOptions[T]) string {
+	opts := DefaultOptions(options...)
+
+	typeT := TypeName[T]()
+
+	RegisterFactory(typeT, func(name string, init *SeriesInit) SeriesAny {
+		s := NewSeries[T](name, init)
+		s.SetIsEqualFunc(opts.IsEqualFunc)
+		s.SetIsLessThanFunc(opts.IsLessThanFunc)
+		s.SetValueToStringFormatter(opts.ValueFormatter)
+		return s
+	})
+
+	return typeT
+}
+
+// TypeName returns the name of type T used by the registry and returned by
+// Series[T].Type(), e.g. "float64", "*time.Time" or "any".
+func TypeName[T any]() string {
+	return formatTypeOf(*new(T))
+}
+
+// RegisterFactory registers factory for the type name. It can be used to
+// register series implementations other than Series[T].
+func RegisterFactory(typeName string, factory SeriesFactory) {
+	registry.Lock(); defer registry.Unlock()
+
+	registry.factories[typeName] = factory
+}
+
+// NewSeriesFromType creates series of the registered type. Size of the series
+// can be prealocated by passing `init`.
+//
+// Example:
+//
+//	s, err := NewSeriesFromType("float64", "x", nil) // *Series[float64]
+//
+func NewSeriesFromType(typeName, name string, init *SeriesInit) (SeriesAny, error) {
+	registry.RLock()
+	factory, ok := registry.factories[typeName]
+	registry.RUnlock()
+
+	if !ok {
+		return nil, errors.New("type is not registered: " + typeName)
+	}
+
+	return factory(name, init), nil
+}
+
+// RegisteredTypes returns sorted names of all the registered types.
+func RegisteredTypes() []string {
+	registry.RLock(); defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// registerOrdered registers ordered type and pointer to this type
+func registerOrdered[T constraints.Ordered]() {
+	RegisterType(TypeOptions[T]{
+		IsEqualFunc:    IsEqualFunc[T],
+		IsLessThanFunc: IsLessThanFunc[T],
+	})
+	RegisterType(TypeOptions[*T]{
+		IsEqualFunc:    IsEqualPtrFunc[T],
+		IsLessThanFunc: IsLessThanPtrFunc[T],
+	})
+}
+
+func init() {
+	registerOrdered[float64]()
+	registerOrdered[float32]()
+	registerOrdered[int]()
+	registerOrdered[int64]()
+	registerOrdered[int32]()
+	registerOrdered[int16]()
+	registerOrdered[int8]()
+	registerOrdered[uint]()
+	registerOrdered[uint64]()
+	registerOrdered[uint32]()
+	registerOrdered[uint16]()
+	registerOrdered[uint8]()
+	registerOrdered[string]()
+
+	RegisterType(TypeOptions[bool]{
+		IsEqualFunc:    IsEqualFunc[bool],
+		IsLessThanFunc: func(a, b bool) bool { return !a && b },
+	})
+	RegisterType(TypeOptions[*bool]{
+		IsEqualFunc: IsEqualPtrFunc[bool],
+	})
+
+	RegisterType(TypeOptions[complex128]{IsEqualFunc: IsEqualFunc[complex128]})
+	RegisterType(TypeOptions[complex64]{IsEqualFunc: IsEqualFunc[complex64]})
+
+	RegisterType(TypeOptions[time.Time]{
+		IsEqualFunc:    func(a, b time.Time) bool { return a.Equal(b) },
+		IsLessThanFunc: func(a, b time.Time) bool { return a.Before(b) },
+	})
+	RegisterType(TypeOptions[*time.Time]{
+		IsEqualFunc: func(a, b *time.Time) bool {
+			if a == nil || b == nil {
+				return a == b
+			}
+			return a.Equal(*b)
+		},
+		IsLessThanFunc: func(a, b *time.Time) bool {
+			if a == nil {
+				return true
+			}
+			if b == nil {
+				return false
+			}
+			return a.Before(*b)
+		},
+	})
+
+	RegisterType[any]()
+
+	RegisterFactory("categorical", func(name string, init *SeriesInit) SeriesAny {
+		ci := &CategoricalInit{}
+		if init != nil {
+			ci.Size, ci.Capacity, ci.MaxLen = init.Size, init.Capacity, init.MaxLen
+		}
+		return NewCategorical(name, ci)
+	})
+}
@@ -0,0 +1,296 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// PivotAggFn is used by Pivot to aggregate values with the same index and column.
+// vals contains all the values (in order of rows) and the returned value must be of
+// the same type as the values series.
+type PivotAggFn func(vals []any) any
+
+// PivotOptions is defined as an optional parameters
+// for Pivot(...).
+//
+// Defaults:
+// 		PivotOptions { DontLock: false }
+//
+// Properties:
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type PivotOptions struct {
+	DontLock bool
+}
+
+// MeltOptions is defined as an optional parameters
+// for Melt(...) and Stack(...).
+//
+// Defaults:
+// 		MeltOptions { VarName: "variable", ValueName: "value", DontLock: false }
+//
+// Properties:
+//	• `VarName` - name of the series containing names of melted series
+//	• `ValueName` - name of the series containing values of melted series
+//	• `DontLock` - if set to true, then operation is performed without locking RWMutex
+type MeltOptions struct {
+	VarName, ValueName string
+	DontLock bool
+}
+
+// Pivot reshapes dataframe from long to wide format. Distinct values of the index series
+// become rows and distinct values of the columns series become new series containing
+// values of the values series. Names of the new series are string representations of
+// column values. Keys are ordered by IsLessThanFunc of the index and columns series,
+// or in order of their first appearance if IsLessThanFunc is not set. Missing combinations
+// are filled with null (NaN for floats, nil for pointers and interfaces, zero value otherwise).
+// If aggFn is nil, duplicate combinations of index and column are reported as an error.
+//
+// Example:
+//
+//	// time, symbol, close -> time, EURUSD, GBPUSD, ...
+//	wide, err := Pivot(ctx, df, "time", "symbol", "close", nil)
+//
+func Pivot(ctx context.Context, df *DataFrame, index, columns, values string, aggFn PivotAggFn, options ...PivotOptions) (*DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	var is, cs, vs SeriesAny
+	for _, v := range []struct {
+		name   string
+		series *SeriesAny
+	}{{index, &is}, {columns, &cs}, {values, &vs}} {
+		col, err := df.NameToColumn(v.name, dontLock)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + v.name)
+		}
+		*v.series = df.Series[col]
+	}
+
+	rowKeys := newKeyIndex(is.IsEqualAnyFunc)
+	colKeys := newKeyIndex(cs.IsEqualAnyFunc)
+
+	// first row of every column key is used to format series name
+	colRows := []int{}
+
+	cells := map[[2]int][]any{}
+
+	for row := 0; row < df.n; row++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r, _ := rowKeys.add(is.ValueAny(row, dontLock))
+		c, added := colKeys.add(cs.ValueAny(row, dontLock))
+		if added {
+			colRows = append(colRows, row)
+		}
+
+		cell := [2]int{r, c}
+		cells[cell] = append(cells[cell], vs.ValueAny(row, dontLock))
+	}
+
+	rowOrder := keyOrder(is, rowKeys)
+	colOrder := keyOrder(cs, colKeys)
+
+	ni := is.emptyCopy(rowKeys.len())
+	for _, r := range rowOrder {
+		ni.AppendAny(rowKeys.keys[r], dontLock)
+	}
+
+	series := []SeriesAny{ni}
+	names := map[string]bool{index: true}
+
+	for _, c := range colOrder {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		name := cs.ValueString(colRows[c], dontLock)
+		if names[name] {
+			return nil, errors.New("names of series must be unique: " + name)
+		}
+		names[name] = true
+
+		ns := vs.emptyCopy(rowKeys.len())
+		ns.Rename(name, dontLock)
+
+		for _, r := range rowOrder {
+			vals := cells[[2]int{r, c}]
+
+			switch {
+			case len(vals) == 0:
+				ns.appendNulls(1)
+			case aggFn != nil:
+				ns.AppendAny(aggFn(vals), dontLock)
+			case len(vals) == 1:
+				ns.AppendAny(vals[0], dontLock)
+			default:
+				return nil, fmt.Errorf("duplicate values for index %v and column %s", rowKeys.keys[r], name)
+			}
+		}
+
+		series = append(series, ns)
+	}
+
+	return NewDataFrame(series...), nil
+}
+
+// Unstack reshapes dataframe from long to wide format. It is equivalent to Pivot
+// without aggregation.
+func Unstack(ctx context.Context, df *DataFrame, index, columns, values string, options ...PivotOptions) (*DataFrame, error) {
+	return Pivot(ctx, df, index, columns, values, nil, options...)
+}
+
+// keyOrder returns ids of keys sorted by IsLessThanFunc of the series
+func keyOrder(s SeriesAny, keys *keyIndex) []int {
+	order := make([]int, keys.len())
+	for i := range order {
+		order[i] = i
+	}
+
+	if s.hasIsLessThanFunc() {
+		sort.SliceStable(order, func(i, j int) bool {
+			return s.IsLessThanAnyFunc(keys.keys[order[i]], keys.keys[order[j]])
+		})
+	}
+
+	return order
+}
+
+// Melt reshapes dataframe from wide to long format. Series idVars are kept as
+// identifiers and series valueVars are unpivoted into two series: the variable
+// series containing names of valueVars and the value series containing their
+// values. If valueVars is empty, all the series which are not in idVars are used.
+// The value series has the type of valueVars if all of them are of the same
+// type, otherwise it is a series of type any. Rows are ordered by valueVars.
+//
+// Example:
+//
+//	// time, EURUSD, GBPUSD -> time, symbol, close
+//	long, err := Melt(ctx, wide, []string { "time" }, nil, MeltOptions { VarName: "symbol", ValueName: "close" })
+//
+func Melt(ctx context.Context, df *DataFrame, idVars, valueVars []string, options ...MeltOptions) (*DataFrame, error) {
+	return melt(ctx, df, idVars, valueVars, false, options...)
+}
+
+// Stack reshapes dataframe from wide to long format. It is equivalent to Melt
+// of all the series which are not in idVars, but rows are ordered by the
+// original rows.
+func Stack(ctx context.Context, df *DataFrame, idVars []string, options ...MeltOptions) (*DataFrame, error) {
+	return melt(ctx, df, idVars, nil, true, options...)
+}
+
+func melt(ctx context.Context, df *DataFrame, idVars, valueVars []string, byRows bool, options ...MeltOptions) (*DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if opts.VarName == "" {
+		opts.VarName = "variable"
+	}
+
+	if opts.ValueName == "" {
+		opts.ValueName = "value"
+	}
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	ids := make([]SeriesAny, 0, len(idVars))
+	isID := map[string]bool{}
+
+	for _, name := range idVars {
+		col, err := df.NameToColumn(name, dontLock)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		ids = append(ids, df.Series[col])
+		isID[name] = true
+	}
+
+	if len(valueVars) == 0 {
+		for _, s := range df.Series {
+			if name := s.Name(dontLock); !isID[name] {
+				valueVars = append(valueVars, name)
+			}
+		}
+	}
+
+	vals := make([]SeriesAny, 0, len(valueVars))
+	sameType := true
+
+	for _, name := range valueVars {
+		col, err := df.NameToColumn(name, dontLock)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		s := df.Series[col]
+		sameType = sameType && (len(vals) == 0 || vals[0].Type() == s.Type())
+		vals = append(vals, s)
+	}
+
+	if len(vals) == 0 {
+		return nil, errors.New("no series to melt")
+	}
+
+	nRows := df.n * len(vals)
+
+	series := make([]SeriesAny, 0, len(ids)+2)
+	for _, s := range ids {
+		series = append(series, s.emptyCopy(nRows))
+	}
+
+	variable := NewSeries[string](opts.VarName, &SeriesInit{Capacity: nRows})
+
+	var value SeriesAny
+	if sameType {
+		value = vals[0].emptyCopy(nRows)
+		value.Rename(opts.ValueName, dontLock)
+	} else {
+		value = NewSeries[any](opts.ValueName, &SeriesInit{Capacity: nRows})
+	}
+
+	appendRow := func(row, v int) {
+		for i, s := range ids {
+			series[i].AppendAny(s.ValueAny(row, dontLock), dontLock)
+		}
+		variable.Append([]string{valueVars[v]}, dontLock)
+		value.AppendAny(vals[v].ValueAny(row, dontLock), dontLock)
+	}
+
+	if byRows {
+		for row := 0; row < df.n; row++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			for v := range vals {
+				appendRow(row, v)
+			}
+		}
+	} else {
+		for v := range vals {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			for row := 0; row < df.n; row++ {
+				appendRow(row, v)
+			}
+		}
+	}
+
+	names := map[string]bool{}
+	for _, s := range append(series, variable, value) {
+		if names[s.Name(dontLock)] {
+			return nil, errors.New("names of series must be unique: " + s.Name(dontLock))
+		}
+		names[s.Name(dontLock)] = true
+	}
+
+	return NewDataFrame(append(series, variable, value)...), nil
+}
@@ -2,6 +2,8 @@ package dataframe
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 	"strings"
 )
 
@@ -12,6 +14,43 @@ func isNaN(f any) bool {
 	return false
 }
 
-func formatType[T any]() string {
-	return strings.Replace(fmt.Sprintf("%T", *new(T)), "<nil>", "any", 1)
-}
\ No newline at end of file
+// formatTypeOf returns the name of the type of v, "any" for nil
+func formatTypeOf(v any) string {
+	return strings.Replace(fmt.Sprintf("%T", v), "<nil>", "any", 1)
+}
+
+// nilValue returns zero value of T when nil is assignable to T (interfaces,
+// pointers, slices, maps, ...), otherwise it panics.
+func nilValue[T any]() T {
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return *new(T)
+	}
+	panic(fmt.Sprintf("nil is not a valid value of type %s", TypeName[T]()))
+}
+
+// nullValue returns value which represents null for type T. It is NaN for
+// floats and zero value for other types (nil for pointers and interfaces).
+func nullValue[T any]() T {
+	var null T
+	switch v := any(&null).(type) {
+	case *float64:
+		*v = math.NaN()
+	case *float32:
+		*v = float32(math.NaN())
+	}
+	return null
+}
+// isNull returns true if v is null: NaN, nil or nil pointer.
+func isNull(v any) bool {
+	if v == nil || isNaN(v) {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	}
+	return false
+}
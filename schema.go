@@ -0,0 +1,60 @@
+package dataframe
+
+import "errors"
+
+// SchemaField describes a series of the DataFrame.
+type SchemaField struct {
+	// Name of the series.
+	Name string
+
+	// Type of the series as returned by SeriesAny.Type().
+	Type string
+}
+
+// Schema returns the name and the type of all the series.
+func (df *DataFrame) Schema(options ...Options) []SchemaField {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	schema := make([]SchemaField, 0, len(df.Series))
+	for _, aSeries := range df.Series {
+		schema = append(schema, SchemaField{aSeries.Name(), aSeries.Type()})
+	}
+
+	return schema
+}
+
+// NewDataFrameFromSchema creates a dataframe with series of registered types
+// defined by schema. Size of all the series can be prealocated by passing `init`.
+// See RegisterType.
+//
+// Example:
+//
+//	df, err := NewDataFrameFromSchema([]SchemaField {
+//		{ Name: "time", Type: "time.Time" },
+//		{ Name: "price", Type: "float64" },
+//	}, nil)
+//
+func NewDataFrameFromSchema(schema []SchemaField, init *SeriesInit) (*DataFrame, error) {
+	names := map[string]bool{}
+	series := make([]SeriesAny, 0, len(schema))
+
+	for _, field := range schema {
+		if names[field.Name] {
+			return nil, errors.New("names of series must be unique: " + field.Name)
+		}
+		names[field.Name] = true
+
+		s, err := NewSeriesFromType(field.Type, field.Name, init)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, s)
+	}
+
+	return NewDataFrame(series...), nil
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 
 	"github.com/olekukonko/tablewriter"
 	// "github.com/google/go-cmp/cmp"
@@ -20,10 +21,27 @@ type Series[T any] struct {
 	
 	isEqualFunc, isLessThanFunc CompareFn[T]
 
+	// maximal number of rows, 0 if the series is not capped
+	maxLen int
+
+	// underlying buffer of the capped series
+	buf []T
+
+	// 1 if Values are shared with another series and must be copied
+	// before they are changed. It is set atomically, because Copy marks
+	// the series shared under the read lock.
+	shared int32
+
+	// subscriptions of changes
+	events hub[T]
+
 	// Values is exported to better improve interoperability with the gonum package.
 	//
 	// See: https://godoc.org/gonum.org/v1/gonum
 	//
+	// Values of a capped series are a window of the underlying buffer,
+	// they are always in the logical order.
+	//
 	// WARNING: Do not modify directly.
 	Values   []T
 
@@ -43,7 +61,7 @@ func NewSeries[T any](name string, init *SeriesInit, vals ...T) *Series[T] {
 	s := &Series[T] {
 		name: name,
 		valFormatter: DefaultValueFormatter,
-		typeT: formatType[T](),
+		typeT: TypeName[T](),
 		isEqualFunc: IsEqualDefaultFunc[T],
 		Values: []T{},
 	}
@@ -53,6 +71,7 @@ func NewSeries[T any](name string, init *SeriesInit, vals ...T) *Series[T] {
 	if init != nil {
 		size = init.Size
 		capacity = init.Capacity
+		s.maxLen = init.MaxLen
 	}
 
 	if size < len(vals) {
@@ -75,6 +94,7 @@ func NewSeries[T any](name string, init *SeriesInit, vals ...T) *Series[T] {
 	// }
 
 	s.fillDefault(s.Values, len(vals), size)
+	s.evict()
 	return s
 }
 
@@ -156,18 +176,25 @@ func (s *Series[T]) ValueString(row int, options ...Options) string {
 // series.
 func (s *Series[T]) Prepend(val []T, options ...Options) {
 	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
 	
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
+
+	s.detach()
 	
 	// See: https://stackoverflow.com/questions/41914386/what-is-the-mechanism-of-using-append-to-prepend-in-go
 	
 	if cap(s.Values) > len(s.Values) + len(val) {
 		// There is already extra capacity so copy current values by 1 spot
+		n := len(s.Values)
 		s.Values = s.Values[:len(s.Values) + len(val)]
 		copy(s.Values[len(val):], s.Values)
 		copy(s.Values, val)
+		s.evict()
+		s.inserted(0, n, val)
 		return
 	}
 
@@ -178,6 +205,8 @@ func (s *Series[T]) Prepend(val []T, options ...Options) {
 // Append is used to set a value to the end of the series.
 func (s *Series[T]) Append(val []T, options ...Options) int {
 	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
 	
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
@@ -193,6 +222,8 @@ func (s *Series[T]) Append(val []T, options ...Options) int {
 // are shifted by 1.
 func (s *Series[T]) Insert(row int, val []T, options ...Options) {
 	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
 	
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
@@ -202,16 +233,162 @@ func (s *Series[T]) Insert(row int, val []T, options ...Options) {
 }
 
 func (s *Series[T]) insert(row int, val []T) {
-	s.Values = append(s.Values[:row], append(val, s.Values[row:]...)...)
+	n := len(s.Values)
+
+	// copies have limited capacity, so values appended to the end do not
+	// change them unless the capped series moves its values
+	if row != n || s.maxLen > 0 {
+		s.detach()
+	}
+
+	if s.maxLen > 0 && row == n {
+		s.appendCapped(val)
+	} else {
+		s.Values = append(s.Values[:row], append(val, s.Values[row:]...)...)
+		s.evict()
+	}
+
+	s.inserted(row, n, val)
+}
+
+// inserted queues events of values inserted at row into n rows
+func (s *Series[T]) inserted(row, n int, val []T) {
+	if !s.events.active() {
+		return
+	}
+
+	evicted, newRow, count := insertedRange(row, n, len(val), len(s.Values))
+	if evicted > 0 {
+		s.events.enqueue(Event[T]{Kind: EVENT_EVICT, Count: evicted})
+	}
+
+	if count > 0 {
+		vals := append([]T(nil), val[len(val) - count:]...)
+		s.events.enqueue(Event[T]{Kind: insertedKind(row, n), Row: newRow, Count: count, Values: vals})
+	}
+}
+
+// appendCapped appends values to the capped series. Values are a window of
+// buf with capacity of 2*maxLen. Evicted rows are dropped by moving the
+// beginning of the window and the window is moved to the beginning of buf only
+// when buf is full, so appends take amortized O(1).
+func (s *Series[T]) appendCapped(val []T) {
+	if len(val) >= s.maxLen {
+		val = val[len(val) - s.maxLen:]
+	}
+
+	if drop := len(s.Values) + len(val) - s.maxLen; drop > 0 {
+		s.Values = s.Values[drop:]
+	}
+
+	if cap(s.Values) - len(s.Values) < len(val) {
+		if cap(s.buf) < 2 * s.maxLen {
+			s.buf = make([]T, 2 * s.maxLen)
+		}
+
+		n := copy(s.buf[:cap(s.buf)], s.Values)
+		s.Values = s.buf[:n]
+	}
+
+	s.Values = append(s.Values, val...)
+}
+
+// detach copies values shared with another series before they are changed
+func (s *Series[T]) detach() {
+	if atomic.LoadInt32(&s.shared) == 1 {
+		s.realloc()
+	}
+}
+
+// realloc copies values, so copies and views sharing them are not changed
+func (s *Series[T]) realloc() {
+	s.Values = append(make([]T, 0, len(s.Values)), s.Values...)
+	s.buf = nil
+	atomic.StoreInt32(&s.shared, 0)
+}
+
+// evict removes the oldest rows of the capped series exceeding maxLen
+func (s *Series[T]) evict() {
+	if s.maxLen > 0 && len(s.Values) > s.maxLen {
+		s.Values = s.Values[len(s.Values) - s.maxLen:]
+	}
+}
+
+// Subscribe calls fn with events of changes made by Append, Prepend, Insert,
+// Update, Remove, Sort, Swap and Reset. Sorting of the DataFrame is reported
+// as EVENT_SORT too. Events are delivered in the order of changes after the
+// lock is released. The subscription is stopped by Unsubscribe.
+//
+// Example:
+//
+//	sub := s.Subscribe(func(ev Event[float64]) {
+//		fmt.Println(ev.Kind, ev.Row, ev.Values)
+//	}, SubscribeOptions { Buffer: 1024, Backpressure: DROP_OLDEST })
+//	defer sub.Unsubscribe()
+//
+func (s *Series[T]) Subscribe(fn func(Event[T]), options ...SubscribeOptions) *Subscription[T] {
+	return s.events.subscribe(fn, DefaultOptions(options...))
+}
+
+// SetMaxLen caps the series to n rows. Appending to the full series evicts
+// the oldest rows. If the series has more than n rows, the oldest rows are
+// removed immediately. If n is 0, the series is not capped.
+//
+// Example:
+//
+//	s := NewSeries[float64]("price", &SeriesInit{MaxLen: 1000})
+//	s.SetMaxLen(500)
+//
+func (s *Series[T]) SetMaxLen(n int, options ...Options) {
+	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
+
+	if !opts.DontLock {
+		s.Lock(); defer s.Unlock()
+	}
+
+	if n < 0 {
+		panic("MaxLen must not be negative")
+	}
+
+	s.maxLen = n
+
+	nRows := len(s.Values)
+	s.evict()
+
+	if evicted := nRows - len(s.Values); evicted > 0 && s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_EVICT, Count: evicted})
+	}
+}
+
+// MaxLen returns the maximal number of rows of the capped series or 0
+// if the series is not capped.
+func (s *Series[T]) MaxLen(options ...Options) int {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	return s.maxLen
 }
 
 // Remove is used to delete the value of a particular row.
 func (s *Series[T]) Remove(row int, options ...Options) {
 	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
 	
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
+
+	if s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_REMOVE, Row: row, Count: 1, Values: []T{s.Values[row]}})
+	}
+
+	s.detach()
 	
 	s.Values = append(s.Values[:row], s.Values[row+1:]...)
 }
@@ -219,10 +396,16 @@ func (s *Series[T]) Remove(row int, options ...Options) {
 // Reset is used clear all data contained in the Series.
 func (s *Series[T]) Reset(options ...Options) {
 	opts := DefaultOptions(options...)
+
+	defer s.events.flush()
 	
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
+
+	if s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_RESET, Count: len(s.Values)})
+	}
 	
 	s.Values = []T{}
 }
@@ -231,6 +414,8 @@ func (s *Series[T]) Reset(options ...Options) {
 func (s *Series[T]) Update(row int, val T, options ...Options) {
 	opts := DefaultOptions(options...)
 
+	defer s.events.flush()
+
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
@@ -239,7 +424,12 @@ func (s *Series[T]) Update(row int, val T, options ...Options) {
 		row = len(s.Values) + row
 	}
 
+	s.detach()
 	s.Values[row] = val
+
+	if s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_UPDATE, Row: row, Count: 1, Values: []T{val}})
+	}
 }
 
 // valuesIterator will return a function that can be used to iterate through all the values.
@@ -306,11 +496,14 @@ func (s *Series[T]) Swap(row1, row2 int, options ...Options) {
 
 	opts := DefaultOptions(options...)
 
+	defer s.events.flush()
+
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
 
-	s.Values[row1], s.Values[row2] = s.Values[row2], s.Values[row1]
+	s.swap(row1, row2)
+	s.sorted()
 }
 
 // IsEqualFunc returns true if a is equal to b.
@@ -365,6 +558,8 @@ func (s *Series[T]) Sort(ctx context.Context, options ...SortOptions) (completed
 
 	opts := DefaultOptions(options...)
 
+	defer s.events.flush()
+
 	if !opts.DontLock {
 		s.Lock(); defer s.Unlock()
 	}
@@ -381,6 +576,11 @@ func (s *Series[T]) Sort(ctx context.Context, options ...SortOptions) (completed
 		return s.isLessThanFunc(s.Values[i], s.Values[j])
 	}
 
+	s.detach()
+
+	// rows are moved even if sorting is canceled
+	defer s.sorted()
+
 	if opts.Stable {
 		sort.SliceStable(s.Values, sortFunc)
 	} else {
@@ -390,7 +590,9 @@ func (s *Series[T]) Sort(ctx context.Context, options ...SortOptions) (completed
 	return true
 }
 
-// Copy will create a new copy of the series.
+// Copy will create a new copy of the series. The copy shares values with
+// the series until one of them is changed (copy-on-write), so Copy takes O(1)
+// and the values are copied by the first change.
 // It is recommended that you lock the Series before attempting
 // to Copy.
 func (s *Series[T]) Copy(options ...RangeOptions) *Series[T] {
@@ -402,6 +604,7 @@ func (s *Series[T]) Copy(options ...RangeOptions) *Series[T] {
 			isLessThanFunc: s.isLessThanFunc,
 			name:         	s.name,
 			typeT: 			s.typeT,
+			maxLen: 		s.maxLen,
 			Values:       	[]T{},
 		}
 	}
@@ -413,9 +616,9 @@ func (s *Series[T]) Copy(options ...RangeOptions) *Series[T] {
 		panic(err)
 	}
 
-	// Copy slice
-	x := s.Values[start : end + 1]
-	newSlice := append(x[:0:0], x...)
+	// Share slice, its capacity is limited, so appending to the copy
+	// allocates a new one
+	atomic.StoreInt32(&s.shared, 1)
 
 	return &Series[T]{
 		valFormatter: 	s.valFormatter,
@@ -423,10 +626,39 @@ func (s *Series[T]) Copy(options ...RangeOptions) *Series[T] {
 		isLessThanFunc: s.isLessThanFunc,
 		name:         	s.name,
 		typeT: 			s.typeT,
-		Values:       	newSlice,
+		maxLen: 		s.maxLen,
+		Values:       	s.Values[start : end + 1 : end + 1],
+		shared: 		1,
 	}
 }
 
+// Shift returns a new series with values shifted by n rows. Positive n shifts
+// values forward (towards the end) and negative n backward. Vacated rows are
+// filled with null (NaN for floats, nil for pointers and interfaces, zero value
+// otherwise).
+func (s *Series[T]) Shift(n int, options ...Options) *Series[T] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := s.emptyCopy(len(s.Values)).(*Series[T])
+	ns.Values = ns.Values[:len(s.Values)]
+
+	null := nullValue[T]()
+
+	for i := range ns.Values {
+		if j := i - n; j >= 0 && j < len(s.Values) {
+			ns.Values[i] = s.Values[j]
+		} else {
+			ns.Values[i] = null
+		}
+	}
+
+	return ns
+}
+
 // Table will produce the Series in a table.
 func (s *Series[T]) Table(options ...TableOptions) string {
 	opts := DefaultOptions(options...)
@@ -435,19 +667,33 @@ func (s *Series[T]) Table(options ...TableOptions) string {
 		s.RLock(); defer s.RUnlock()
 	}
 
+	return seriesTable(s.name, s.Type(), len(s.Values), func(row int) string {
+		return s.valFormatter(s.Values[row])
+	}, opts.Range)
+}
+
+// String implements the fmt.Stringer interface. It does not lock the Series.
+func (s *Series[T]) String() string {
+	return seriesString(s.name, len(s.Values), func(row int) string {
+		return s.valFormatter(s.Values[row])
+	})
+}
+
+// seriesTable renders n values of a series in a table
+func seriesTable(name, typ string, n int, valueString func(row int) string, r RangeOptions) string {
 	data := [][]string{}
 
-	headers := []string{"", s.name} // row header is blank
-	footers := []string{fmt.Sprintf("%dx%d", len(s.Values), 1), s.Type()}
+	headers := []string{"", name} // row header is blank
+	footers := []string{fmt.Sprintf("%dx%d", n, 1), typ}
 
-	if len(s.Values) > 0 {
-		start, end, err := opts.Range.Limits(len(s.Values))
+	if n > 0 {
+		start, end, err := r.Limits(n)
 		if err != nil {
 			panic(err)
 		}
 
 		for row := start; row <= end; row++ {
-			sVals := []string{ fmt.Sprintf("%d:", row), s.ValueString(row) }
+			sVals := []string{ fmt.Sprintf("%d:", row), valueString(row) }
 			data = append(data, sVals)
 		}
 	}
@@ -467,12 +713,10 @@ func (s *Series[T]) Table(options ...TableOptions) string {
 	return buf.String()
 }
 
-// String implements the fmt.Stringer interface. It does not lock the Series.
-func (s *Series[T]) String() string {
-
-	count := len(s.Values)
+// seriesString returns short representation of n values of a series
+func seriesString(name string, count int, valueString func(row int) string) string {
 
-	out := s.name + ": [ "
+	out := name + ": [ "
 
 	if count > 6 {
 		idx := []int{0, 1, 2, count - 3, count - 2, count - 1}
@@ -480,13 +724,13 @@ func (s *Series[T]) String() string {
 			if j == 3 {
 				out = out + "... "
 			}
-			out = out + s.valFormatter(s.Values[row]) + " "
+			out = out + valueString(row) + " "
 		}
 		return out + "]"
 	}
 
-	for row := range s.Values {
-		out = out + s.valFormatter(s.Values[row]) + " "
+	for row := 0; row < count; row++ {
+		out = out + valueString(row) + " "
 	}
 	return out + "]"
 
@@ -495,6 +739,7 @@ func (s *Series[T]) String() string {
 // FillRand will fill a Series with random data. 
 func (s *Series[T]) FillRand(rnd RandFn[T]) {
 
+	s.detach()
 
 	for i := 0; i < len(s.Values); i++ {
 		s.Values[i] = rnd()
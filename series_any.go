@@ -1,6 +1,9 @@
 package dataframe
 
-import "context"
+import (
+	"context"
+	"sync/atomic"
+)
 
 // ValueAny returns the value of a particular row.
 func (s *Series[T]) ValueAny(row int, options ...Options) any {
@@ -15,6 +18,8 @@ func (s *Series[T]) PrependAny(val any, options ...Options) {
 		s.Prepend(v, options...)
 	case T:
 		s.Prepend([]T { v }, options...)
+	case nil:
+		s.Prepend([]T { nilValue[T]() }, options...)
 	default:
 		// raise panic
 		_ = val.(T)
@@ -28,6 +33,8 @@ func (s *Series[T]) AppendAny(val any, options ...Options) int {
 		return s.Append(v, options...)
 	case T:
 		return s.Append([]T { v }, options...)
+	case nil:
+		return s.Append([]T { nilValue[T]() }, options...)
 	}
 
 	return s.Append([]T { val.(T) }, options...)
@@ -42,6 +49,8 @@ func (s *Series[T]) InsertAny(row int, val any, options ...Options) {
 		s.Insert(row, v, options...)
 	case T:
 		s.Insert(row, []T { v }, options...)
+	case nil:
+		s.Insert(row, []T { nilValue[T]() }, options...)
 	default:
 		// raise panic
 		_ = val.(T)
@@ -53,6 +62,8 @@ func (s *Series[T]) UpdateAny(row int, val any, options ...Options) {
 	switch v := val.(type) {
 	case T:
 		s.Update(row, v, options...)
+	case nil:
+		s.Update(row, nilValue[T](), options...)
 	default:
 		// raise panic
 		_ = val.(T)
@@ -97,6 +108,11 @@ func (s *Series[T]) CopyAny(options ...RangeOptions) SeriesAny {
 	return s.Copy(options...)
 }
 
+// ShiftAny returns a new series with values shifted by n rows. See Shift.
+func (s *Series[T]) ShiftAny(n int, options ...Options) SeriesAny {
+	return s.Shift(n, options...)
+}
+
 func (s *Series[T]) cloneAsEmpty(size ...int) SeriesAny {
 	var _size, _capacity = len(s.Values), len(s.Values)
 
@@ -119,6 +135,69 @@ func (s *Series[T]) cloneAsEmpty(size ...int) SeriesAny {
 	}
 }
 
+func (s *Series[T]) emptyCopy(capacity int) SeriesAny {
+	return &Series[T]{
+		valFormatter: 	s.valFormatter,
+		isEqualFunc: 	s.isEqualFunc,
+		isLessThanFunc: s.isLessThanFunc,
+		name:         	s.name,
+		typeT: 			s.typeT,
+		Values:       	make([]T, 0, capacity),
+	}
+}
+
+func (s *Series[T]) assign(src SeriesAny) {
+	ns := src.(*Series[T])
+
+	changed := len(s.Values) != len(ns.Values) ||
+		(len(s.Values) > 0 && &s.Values[0] != &ns.Values[0])
+
+	s.valFormatter, s.isEqualFunc, s.isLessThanFunc = ns.valFormatter, ns.isEqualFunc, ns.isLessThanFunc
+	s.name, s.maxLen = ns.name, ns.maxLen
+
+	if !changed {
+		return
+	}
+
+	// src is not used anymore, so its values are taken as they are
+	s.Values, s.buf = ns.Values, ns.buf
+	atomic.StoreInt32(&s.shared, atomic.LoadInt32(&ns.shared))
+
+	if s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_REPLACE, Count: len(s.Values)})
+	}
+}
+
+func (s *Series[T]) flushEvents() {
+	s.events.flush()
+}
+
+func (s *Series[T]) swap(row1, row2 int) {
+	s.detach()
+	s.Values[row1], s.Values[row2] = s.Values[row2], s.Values[row1]
+}
+
+func (s *Series[T]) sorted() {
+	if s.events.active() {
+		s.events.enqueue(Event[T]{Kind: EVENT_SORT, Count: len(s.Values)})
+	}
+}
+
+func (s *Series[T]) appendSeries(src SeriesAny) {
+	s.Values = append(s.Values, src.(*Series[T]).Values...)
+}
+
+func (s *Series[T]) appendNulls(n int) {
+	null := nullValue[T]()
+	for i := 0; i < n; i++ {
+		s.Values = append(s.Values, null)
+	}
+}
+
+func (s *Series[T]) hasIsLessThanFunc() bool {
+	return s.isLessThanFunc != nil
+}
+
 // FillRandAny will fill a Series with random data. 
 func (s *Series[T]) FillRandAny(rnd RandFn[any]) {
 	s.FillRand(func () T {
@@ -12,4 +12,9 @@ type SeriesInit struct {
 	// it is better to preallocate the capacity of the
 	// underlying slice.
 	Capacity int
+
+	// Maximal number of rows. Appending or inserting into the full
+	// series evicts the first (oldest) rows in amortized O(1).
+	// If MaxLen is 0, the series is not capped.
+	MaxLen int
 }
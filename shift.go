@@ -0,0 +1,126 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number is a constraint for numeric series.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Diff returns a new series with differences between values and values n rows
+// before (or after for negative n). Vacated rows are NaN.
+//
+// Example:
+//
+//	change := Diff(close, 1)
+//
+func Diff[T Number](s *Series[T], n int, options ...Options) *Series[float64] {
+	return lagged(s, n, func(v, prev float64) float64 {
+		return v - prev
+	}, options...)
+}
+
+// PctChange returns a new series with relative changes between values and values n
+// rows before (or after for negative n). Vacated rows are NaN.
+//
+// Example:
+//
+//	returns := PctChange(close, 1)
+//
+func PctChange[T Number](s *Series[T], n int, options ...Options) *Series[float64] {
+	return lagged(s, n, func(v, prev float64) float64 {
+		return v/prev - 1
+	}, options...)
+}
+
+// LogReturn returns a new series with logarithms of ratios between values and values
+// n rows before (or after for negative n). Vacated rows are NaN.
+//
+// Example:
+//
+//	returns := LogReturn(close, 1)
+//
+func LogReturn[T Number](s *Series[T], n int, options ...Options) *Series[float64] {
+	return lagged(s, n, func(v, prev float64) float64 {
+		return math.Log(v / prev)
+	}, options...)
+}
+
+// lagged applies fn to every value and the value n rows before
+func lagged[T Number](s *Series[T], n int, fn func(v, prev float64) float64, options ...Options) *Series[float64] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	vals := make([]float64, len(s.Values))
+
+	for i := range vals {
+		if j := i - n; j >= 0 && j < len(s.Values) {
+			vals[i] = fn(float64(s.Values[i]), float64(s.Values[j]))
+		} else {
+			vals[i] = math.NaN()
+		}
+	}
+
+	return NewSeries(s.name, nil, vals...)
+}
+
+// Lags adds lagged copies of series columns for every lag in lags. New series are
+// named "<name>_lag<lag>" and vacated rows are filled with null (NaN for floats, nil
+// for pointers and interfaces, zero value otherwise). Negative lags produce leads.
+// New series are capped like series added by AddSeries.
+//
+// Example:
+//
+//	// close_lag1, close_lag2, close_lag5, volume_lag1, ...
+//	err := df.Lags([]string { "close", "volume" }, []int { 1, 2, 5 })
+//
+func (df *DataFrame) Lags(columns []string, lags []int, options ...Options) error {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.Lock()
+		defer df.lock.Unlock()
+	}
+
+	names := map[string]bool{}
+	for _, s := range df.Series {
+		names[s.Name(dontLock)] = true
+	}
+
+	series := make([]SeriesAny, 0, len(columns)*len(lags))
+
+	for _, name := range columns {
+		col, err := df.NameToColumn(name, dontLock)
+		if err != nil {
+			return errors.New(err.Error() + ": " + name)
+		}
+
+		for _, lag := range lags {
+			ns := df.Series[col].ShiftAny(lag)
+
+			lagName := fmt.Sprintf("%s_lag%d", name, lag)
+			if names[lagName] {
+				return errors.New("names of series must be unique: " + lagName)
+			}
+			names[lagName] = true
+
+			ns.Rename(lagName, dontLock)
+			series = append(series, ns)
+		}
+	}
+
+	for _, s := range series {
+		df.addSeries(s, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package dataframe
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// PadSide defines where StringAccessor.Pad adds fill characters.
+type PadSide int
+
+const (
+	// PAD_LEFT adds fill characters to the beginning of strings.
+	PAD_LEFT PadSide = 0
+
+	// PAD_RIGHT adds fill characters to the end of strings.
+	PAD_RIGHT PadSide = 1
+
+	// PAD_BOTH adds fill characters to both sides of strings, the right side
+	// gets the extra character.
+	PAD_BOTH PadSide = 2
+)
+
+// StringAccessor provides string operations on Series[string]. All the
+// operations return new series of the same name.
+type StringAccessor struct {
+	s    *Series[string]
+	opts Options
+}
+
+// StringPtrAccessor provides string operations on Series[*string]. All the
+// operations return new series of pointers of the same name, nil values are
+// kept as nil.
+type StringPtrAccessor struct {
+	s    *Series[*string]
+	opts Options
+}
+
+// Str returns string accessor of the series.
+//
+// Example:
+//
+//	isUSD := Str(symbols).HasSuffix("USD")
+//
+func Str(s *Series[string], options ...Options) *StringAccessor {
+	return &StringAccessor{s: s, opts: DefaultOptions(options...)}
+}
+
+// StrPtr returns string accessor of the series of pointers.
+//
+// Example:
+//
+//	exchange := StrPtr(tickers).Extract(regexp.MustCompile(`^(\w+):`), 1)
+//
+func StrPtr(s *Series[*string], options ...Options) *StringPtrAccessor {
+	return &StringPtrAccessor{s: s, opts: DefaultOptions(options...)}
+}
+
+// Contains reports whether strings contain substr.
+func (a *StringAccessor) Contains(substr string) *Series[bool] {
+	return strMap(a, containsFn(substr))
+}
+
+// HasPrefix reports whether strings begin with prefix.
+func (a *StringAccessor) HasPrefix(prefix string) *Series[bool] {
+	return strMap(a, hasPrefixFn(prefix))
+}
+
+// HasSuffix reports whether strings end with suffix.
+func (a *StringAccessor) HasSuffix(suffix string) *Series[bool] {
+	return strMap(a, hasSuffixFn(suffix))
+}
+
+// Match reports whether strings contain any match of re.
+func (a *StringAccessor) Match(re *regexp.Regexp) *Series[bool] {
+	return strMap(a, re.MatchString)
+}
+
+// Extract returns submatch group of the first match of re (0 is the whole match).
+// Strings which don't match are extracted as empty strings.
+func (a *StringAccessor) Extract(re *regexp.Regexp, group int) *Series[string] {
+	return strMap(a, extractFn(re, group))
+}
+
+// Replace replaces matches of re with repl. Inside repl, $ signs are interpreted
+// as in regexp.Expand.
+func (a *StringAccessor) Replace(re *regexp.Regexp, repl string) *Series[string] {
+	return strMap(a, replaceFn(re, repl))
+}
+
+// Split splits strings around sep into n series named "<name>_<i>". The last series
+// contains the unsplit remainder. Missing parts are empty strings.
+func (a *StringAccessor) Split(sep string, n int) []*Series[string] {
+	parts := strMap(a, splitFn(sep, n))
+
+	out := make([]*Series[string], n)
+	for i := range out {
+		out[i] = NewSeries[string](fmt.Sprintf("%s_%d", parts.name, i), &SeriesInit{Capacity: len(parts.Values)})
+		for _, p := range parts.Values {
+			var v string
+			if i < len(p) {
+				v = p[i]
+			}
+			out[i].Values = append(out[i].Values, v)
+		}
+	}
+
+	return out
+}
+
+// TrimSpace removes leading and trailing white space.
+func (a *StringAccessor) TrimSpace() *Series[string] {
+	return strMap(a, strings.TrimSpace)
+}
+
+// Trim removes leading and trailing characters contained in cutset.
+func (a *StringAccessor) Trim(cutset string) *Series[string] {
+	return strMap(a, trimFn(cutset))
+}
+
+// Upper maps strings to upper case.
+func (a *StringAccessor) Upper() *Series[string] {
+	return strMap(a, strings.ToUpper)
+}
+
+// Lower maps strings to lower case.
+func (a *StringAccessor) Lower() *Series[string] {
+	return strMap(a, strings.ToLower)
+}
+
+// Len returns number of characters (runes) of strings.
+func (a *StringAccessor) Len() *Series[int] {
+	return strMap(a, utf8.RuneCountInString)
+}
+
+// Pad pads strings by fill to width characters. Longer strings are kept.
+func (a *StringAccessor) Pad(width int, side PadSide, fill rune) *Series[string] {
+	return strMap(a, padFn(width, side, fill))
+}
+
+// Substring returns characters (runes) from start to end (exclusive). Negative
+// positions are counted from the end of strings and positions out of range are
+// clipped.
+func (a *StringAccessor) Substring(start, end int) *Series[string] {
+	return strMap(a, substringFn(start, end))
+}
+
+// Contains reports whether strings contain substr.
+func (a *StringPtrAccessor) Contains(substr string) *Series[*bool] {
+	return strPtrMap(a, containsFn(substr))
+}
+
+// HasPrefix reports whether strings begin with prefix.
+func (a *StringPtrAccessor) HasPrefix(prefix string) *Series[*bool] {
+	return strPtrMap(a, hasPrefixFn(prefix))
+}
+
+// HasSuffix reports whether strings end with suffix.
+func (a *StringPtrAccessor) HasSuffix(suffix string) *Series[*bool] {
+	return strPtrMap(a, hasSuffixFn(suffix))
+}
+
+// Match reports whether strings contain any match of re.
+func (a *StringPtrAccessor) Match(re *regexp.Regexp) *Series[*bool] {
+	return strPtrMap(a, re.MatchString)
+}
+
+// Extract returns submatch group of the first match of re (0 is the whole match).
+// Strings which don't match are extracted as nil.
+func (a *StringPtrAccessor) Extract(re *regexp.Regexp, group int) *Series[*string] {
+	return strPtrMapNullable(a, func(v string) (string, bool) {
+		m := re.FindStringSubmatch(v)
+		if m == nil || group >= len(m) {
+			return "", false
+		}
+		return m[group], true
+	})
+}
+
+// Replace replaces matches of re with repl. Inside repl, $ signs are interpreted
+// as in regexp.Expand.
+func (a *StringPtrAccessor) Replace(re *regexp.Regexp, repl string) *Series[*string] {
+	return strPtrMap(a, replaceFn(re, repl))
+}
+
+// Split splits strings around sep into n series named "<name>_<i>". The last series
+// contains the unsplit remainder. Missing parts are nil.
+func (a *StringPtrAccessor) Split(sep string, n int) []*Series[*string] {
+	parts := strPtrMap(a, splitFn(sep, n))
+
+	out := make([]*Series[*string], n)
+	for i := range out {
+		out[i] = NewSeries[*string](fmt.Sprintf("%s_%d", parts.name, i), &SeriesInit{Capacity: len(parts.Values)})
+		for _, p := range parts.Values {
+			var v *string
+			if p != nil && i < len(*p) {
+				v = &(*p)[i]
+			}
+			out[i].Values = append(out[i].Values, v)
+		}
+	}
+
+	return out
+}
+
+// TrimSpace removes leading and trailing white space.
+func (a *StringPtrAccessor) TrimSpace() *Series[*string] {
+	return strPtrMap(a, strings.TrimSpace)
+}
+
+// Trim removes leading and trailing characters contained in cutset.
+func (a *StringPtrAccessor) Trim(cutset string) *Series[*string] {
+	return strPtrMap(a, trimFn(cutset))
+}
+
+// Upper maps strings to upper case.
+func (a *StringPtrAccessor) Upper() *Series[*string] {
+	return strPtrMap(a, strings.ToUpper)
+}
+
+// Lower maps strings to lower case.
+func (a *StringPtrAccessor) Lower() *Series[*string] {
+	return strPtrMap(a, strings.ToLower)
+}
+
+// Len returns number of characters (runes) of strings.
+func (a *StringPtrAccessor) Len() *Series[*int] {
+	return strPtrMap(a, utf8.RuneCountInString)
+}
+
+// Pad pads strings by fill to width characters. Longer strings are kept.
+func (a *StringPtrAccessor) Pad(width int, side PadSide, fill rune) *Series[*string] {
+	return strPtrMap(a, padFn(width, side, fill))
+}
+
+// Substring returns characters (runes) from start to end (exclusive). Negative
+// positions are counted from the end of strings and positions out of range are
+// clipped.
+func (a *StringPtrAccessor) Substring(start, end int) *Series[*string] {
+	return strPtrMap(a, substringFn(start, end))
+}
+
+// strMap maps values of the accessor's series by fn
+func strMap[R any](a *StringAccessor, fn func(string) R) *Series[R] {
+	s := a.s
+
+	if !a.opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := NewSeries[R](s.name, &SeriesInit{Capacity: len(s.Values)})
+	for _, v := range s.Values {
+		ns.Values = append(ns.Values, fn(v))
+	}
+
+	return ns
+}
+
+// strPtrMap maps values of the accessor's series by fn, nil values are kept
+func strPtrMap[R any](a *StringPtrAccessor, fn func(string) R) *Series[*R] {
+	return strPtrMapNullable(a, func(v string) (R, bool) {
+		return fn(v), true
+	})
+}
+
+// strPtrMapNullable maps values of the accessor's series by fn, nil values are kept
+// and values for which fn returns false are nil
+func strPtrMapNullable[R any](a *StringPtrAccessor, fn func(string) (R, bool)) *Series[*R] {
+	s := a.s
+
+	if !a.opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := NewSeries[*R](s.name, &SeriesInit{Capacity: len(s.Values)})
+	for _, v := range s.Values {
+		var r *R
+		if v != nil {
+			if res, ok := fn(*v); ok {
+				r = &res
+			}
+		}
+		ns.Values = append(ns.Values, r)
+	}
+
+	return ns
+}
+
+func containsFn(substr string) func(string) bool {
+	return func(v string) bool { return strings.Contains(v, substr) }
+}
+
+func hasPrefixFn(prefix string) func(string) bool {
+	return func(v string) bool { return strings.HasPrefix(v, prefix) }
+}
+
+func hasSuffixFn(suffix string) func(string) bool {
+	return func(v string) bool { return strings.HasSuffix(v, suffix) }
+}
+
+func extractFn(re *regexp.Regexp, group int) func(string) string {
+	return func(v string) string {
+		m := re.FindStringSubmatch(v)
+		if m == nil || group >= len(m) {
+			return ""
+		}
+		return m[group]
+	}
+}
+
+func replaceFn(re *regexp.Regexp, repl string) func(string) string {
+	return func(v string) string { return re.ReplaceAllString(v, repl) }
+}
+
+func splitFn(sep string, n int) func(string) []string {
+	if n < 1 {
+		panic("n must be positive")
+	}
+	return func(v string) []string { return strings.SplitN(v, sep, n) }
+}
+
+func trimFn(cutset string) func(string) string {
+	return func(v string) string { return strings.Trim(v, cutset) }
+}
+
+func padFn(width int, side PadSide, fill rune) func(string) string {
+	return func(v string) string {
+		n := width - utf8.RuneCountInString(v)
+		if n <= 0 {
+			return v
+		}
+
+		switch side {
+		case PAD_LEFT:
+			return strings.Repeat(string(fill), n) + v
+		case PAD_RIGHT:
+			return v + strings.Repeat(string(fill), n)
+		case PAD_BOTH:
+			return strings.Repeat(string(fill), n/2) + v + strings.Repeat(string(fill), n-n/2)
+		}
+		panic(fmt.Sprintf("unknown pad side: %d", side))
+	}
+}
+
+func substringFn(start, end int) func(string) string {
+	return func(v string) string {
+		r := []rune(v)
+
+		from, to := start, end
+		if from < 0 {
+			from += len(r)
+		}
+		if to < 0 {
+			to += len(r)
+		}
+
+		if from < 0 {
+			from = 0
+		}
+		if to > len(r) {
+			to = len(r)
+		}
+		if from >= to {
+			return ""
+		}
+
+		return string(r[from:to])
+	}
+}
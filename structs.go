@@ -0,0 +1,302 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// structField maps a field of a struct to a series
+type structField struct {
+	name  string
+	index []int
+	typ   reflect.Type
+}
+
+// structFields returns all the fields of struct type t which are mapped to series.
+// Exported fields are mapped by the `dataframe:"name"` tag or by their name.
+// Fields tagged as `dataframe:"-"` are skipped. Embedded structs are flattened,
+// names of their fields are prefixed by the tag of the embedded struct.
+func structFields(t reflect.Type) ([]structField, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, errors.New("type must be a struct: " + t.String())
+	}
+
+	fields := []structField{}
+	if err := appendStructFields(&fields, t, "", nil); err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, f := range fields {
+		if names[f.name] {
+			return nil, errors.New("names of series must be unique: " + f.name)
+		}
+		names[f.name] = true
+	}
+
+	return fields, nil
+}
+
+func appendStructFields(fields *[]structField, t reflect.Type, prefix string, index []int) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("dataframe")
+		if tag == "-" {
+			continue
+		}
+
+		idx := append(append([]int{}, index...), i)
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && ft.Kind() == reflect.Struct && !isRegisteredType(ft) {
+			p := prefix
+			if tag != "" {
+				p = prefix + tag + "_"
+			}
+			if err := appendStructFields(fields, ft, p, idx); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if f.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		name := tag
+		if name == "" {
+			name = f.Name
+		}
+
+		*fields = append(*fields, structField{prefix + name, idx, f.Type})
+	}
+
+	return nil
+}
+
+func typeName(t reflect.Type) string {
+	return formatTypeOf(reflect.Zero(t).Interface())
+}
+
+func isRegisteredType(t reflect.Type) bool {
+	registry.RLock(); defer registry.RUnlock()
+
+	_, ok := registry.factories[typeName(t)]
+	return ok
+}
+
+// fieldByIndex returns nested field of v. If alloc is true, nil embedded
+// pointers are allocated, otherwise false is returned for nil embedded pointer.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// StructSchema returns schema of the dataframe created by FromStructs from
+// values of type T.
+func StructSchema[T any]() ([]SchemaField, error) {
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	schema := make([]SchemaField, 0, len(fields))
+	for _, f := range fields {
+		schema = append(schema, SchemaField{f.name, typeName(f.typ)})
+	}
+	return schema, nil
+}
+
+// FromStructs creates a dataframe from slice of structs (or pointers to structs).
+// Every exported field is stored in a series of the field type. The series name is
+// taken from the `dataframe:"name"` tag or from the field name. Fields tagged as
+// `dataframe:"-"` are skipped. Pointer fields are stored as series of pointers, so
+// nil represents null. Fields of embedded structs are flattened, names of their series
+// are prefixed by the tag of the embedded struct and "_". Field types must be registered,
+// see RegisterType. If name is not empty, names of all the series are prefixed by name
+// and "_" (like fields of embedded structs), so frames of several slices can be merged
+// without collisions. ToStructs maps series without the prefix. Fields of nil embedded
+// struct pointers are stored as nulls of their series.
+//
+// Example:
+//
+//	type OHLC struct {
+//		Open  float64 `dataframe:"o"`
+//		Close float64 `dataframe:"c"`
+//	}
+//
+//	type Bar struct {
+//		Time   time.Time `dataframe:"time"`
+//		OHLC             `dataframe:"bid"`
+//		Volume *float64  `dataframe:"v"`
+//	}
+//
+//	df, err := FromStructs("", bars) // series: time, bid_o, bid_c, v
+//	df, err := FromStructs("eurusd", bars) // series: eurusd_time, eurusd_bid_o, ...
+//
+func FromStructs[T any](name string, vals []T) (*DataFrame, error) {
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := ""
+	if name != "" {
+		prefix = name + "_"
+	}
+
+	init := &SeriesInit{Capacity: len(vals)}
+
+	series := make([]SeriesAny, 0, len(fields))
+	for _, f := range fields {
+		s, err := NewSeriesFromType(typeName(f.typ), prefix + f.name, init)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.name, err)
+		}
+		series = append(series, s)
+	}
+
+	for row := range vals {
+		v := reflect.ValueOf(&vals[row]).Elem()
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, fmt.Errorf("nil value at row %d", row)
+			}
+			v = v.Elem()
+		}
+
+		for i, f := range fields {
+			fv, ok := fieldByIndex(v, f.index, false)
+			if !ok {
+				// nil embedded struct
+				series[i].appendNulls(1)
+				continue
+			}
+			series[i].AppendAny(fv.Interface(), dontLock)
+		}
+	}
+
+	return NewDataFrame(series...), nil
+}
+
+// ToStructs creates slice of structs from the dataframe. Series are mapped to fields
+// in the same way as in FromStructs. Series which are not mapped to any field are
+// ignored, fields without series are left with zero value. Values of pointer series
+// can be stored to non pointer fields (nil is stored as zero value) and vice versa.
+// Numeric values are converted to the field type.
+//
+// Example:
+//
+//	bars, err := ToStructs[Bar](df)
+//
+func ToStructs[T any](df *DataFrame, options ...Options) ([]T, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	fields, err := structFields(reflect.TypeOf((*T)(nil)).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	type mapping struct {
+		field  structField
+		series SeriesAny
+	}
+
+	mappings := []mapping{}
+	for _, f := range fields {
+		col, err := df.NameToColumn(f.name, dontLock)
+		if err != nil {
+			continue
+		}
+		mappings = append(mappings, mapping{f, df.Series[col]})
+	}
+
+	out := make([]T, df.n)
+
+	for row := range out {
+		v := reflect.ValueOf(&out[row]).Elem()
+		for v.Kind() == reflect.Ptr {
+			v.Set(reflect.New(v.Type().Elem()))
+			v = v.Elem()
+		}
+
+		for _, m := range mappings {
+			fv, _ := fieldByIndex(v, m.field.index, true)
+
+			if err := setField(fv, m.series.ValueAny(row, dontLock)); err != nil {
+				return nil, fmt.Errorf("row %d, series %s: %w", row, m.field.name, err)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// setField stores val into the field
+func setField(field reflect.Value, val any) error {
+	if val == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+
+	rv := reflect.ValueOf(val)
+
+	if rv.Kind() == reflect.Ptr && field.Kind() != reflect.Ptr {
+		if rv.IsNil() {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	if field.Kind() == reflect.Ptr && rv.Kind() != reflect.Ptr {
+		ptr := reflect.New(field.Type().Elem())
+		if err := setField(ptr.Elem(), rv.Interface()); err != nil {
+			return err
+		}
+		field.Set(ptr)
+		return nil
+	}
+
+	switch {
+	case rv.Type().AssignableTo(field.Type()):
+		field.Set(rv)
+	case isNumberKind(rv.Kind()) && isNumberKind(field.Kind()):
+		field.Set(rv.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot assign %s to %s", rv.Type(), field.Type())
+	}
+
+	return nil
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
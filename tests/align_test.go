@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestReindex(t *testing.T) {
+	ctx := context.Background()
+
+	k := dataframe.NewSeries("k", nil, 1, 3, 5)
+	k.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+
+	df := dataframe.NewDataFrame(k, dataframe.NewSeries("v", nil, 10., 30., 50.))
+
+	keys := dataframe.NewSeries("k", nil, 0, 1, 2, 5, 6)
+
+	r, err := dataframe.Reindex(ctx, df, keys, dataframe.FILL_NULL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.NRows() != 5 || !math.IsNaN(r.Row(0)["v"].(float64)) || r.Row(1)["v"] != 10. || r.Row(4)["k"] != 6 {
+		t.Fatalf(`Reindex(ctx, df, keys, FILL_NULL) = %v, want match for v: [NaN 10 NaN 50 NaN]`, r)
+	}
+
+	r, err = dataframe.Reindex(ctx, df, keys, dataframe.FILL_FORWARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !math.IsNaN(r.Row(0)["v"].(float64)) || r.Row(2)["v"] != 10. || r.Row(4)["v"] != 50. {
+		t.Fatalf(`Reindex(ctx, df, keys, FILL_FORWARD) = %v, want match for v: [NaN 10 10 50 50]`, r)
+	}
+
+	r, err = dataframe.Reindex(ctx, df, keys, dataframe.FILL_BACKWARD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Row(0)["v"] != 10. || r.Row(2)["v"] != 30. || !math.IsNaN(r.Row(4)["v"].(float64)) {
+		t.Fatalf(`Reindex(ctx, df, keys, FILL_BACKWARD) = %v, want match for v: [10 10 30 50 NaN]`, r)
+	}
+}
+
+func TestAlign(t *testing.T) {
+	ctx := context.Background()
+
+	ka := dataframe.NewSeries("time", nil, 1, 2, 4)
+	ka.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+	a := dataframe.NewDataFrame(ka, dataframe.NewSeries("a", nil, 1., 2., 4.))
+
+	kb := dataframe.NewSeries("time", nil, 3, 2)
+	kb.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+	b := dataframe.NewDataFrame(kb, dataframe.NewSeries("b", nil, 3., 2.))
+
+	na, nb, err := dataframe.Align(ctx, a, b, dataframe.OUTER, dataframe.AlignOptions{Key: "time", Fill: dataframe.FILL_FORWARD})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if na.NRows() != 4 || nb.NRows() != 4 || na.Row(2)["time"] != 3 || na.Row(2)["a"] != 2. || nb.Row(3)["b"] != 3. {
+		t.Fatalf(`Align(ctx, a, b, OUTER) = %v, %v, want match for a: [1 2 2 4], b: [NaN 2 3 3]`, na, nb)
+	}
+
+	na, nb, err = dataframe.Align(ctx, a, b, dataframe.INNER, dataframe.AlignOptions{Key: "time"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if na.NRows() != 1 || nb.NRows() != 1 || na.Row(0)["a"] != 2. || nb.Row(0)["b"] != 2. {
+		t.Fatalf(`Align(ctx, a, b, INNER) = %v, %v, want match for a: [2], b: [2]`, na, nb)
+	}
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/csv"
+)
+
+func TestCategorical(t *testing.T) {
+	ctx := context.Background()
+
+	c := dataframe.NewCategorical("size", &dataframe.CategoricalInit{
+		Categories: []string{"S", "M", "L"},
+		Ordered:    true,
+	}, "L", "S", "M")
+
+	c.AppendAny(nil)
+
+	if row := c.AppendAny("XL"); row != 4 {
+		t.Fatalf(`c.AppendAny("XL") = %v, want match for 4`, row)
+	}
+
+	if cats := c.Categories(); len(cats) != 4 || cats[3] != "XL" {
+		t.Fatalf(`c.Categories() = %v, want match for [S M L XL]`, cats)
+	}
+
+	if codes := c.Codes(); codes[0] != 2 || codes[3] != -1 {
+		t.Fatalf(`c.Codes() = %v, want match for [2 0 1 -1 3]`, codes)
+	}
+
+	c.Sort(ctx)
+
+	want := []any{nil, "S", "M", "L", "XL"}
+	for i, v := range want {
+		if c.ValueAny(i) != v {
+			t.Fatalf(`c.Sort(ctx) = %v, want match for %v`, c, want)
+		}
+	}
+
+	qty := dataframe.NewSeries("qty", nil, 1., 2.)
+	qty.SetIsLessThanFunc(dataframe.IsLessThanFunc[float64])
+
+	df := dataframe.NewDataFrame(dataframe.NewCategorical("side", nil, "sell", "buy"), qty)
+	df2 := dataframe.NewDataFrame(dataframe.NewCategorical("side", nil, "buy", "short"), dataframe.NewSeries("qty", nil, 3., 4.))
+
+	ndf, err := dataframe.Concat(ctx, dataframe.ROWS, df, df2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ndf.Sort(ctx, []dataframe.SortKey{{Key: "side"}, {Key: "qty", Desc: true}})
+
+	if ndf.Row(0)["side"] != "buy" || ndf.Row(0)["qty"] != 3. || ndf.Row(3)["side"] != "short" {
+		t.Fatalf(`Concat(ctx, ROWS, df, df2) sorted = %v, want match for side: [buy buy sell short], qty: [3 2 1 4]`, ndf)
+	}
+}
+
+func TestCSVLoadCategorical(t *testing.T) {
+	reader := strings.NewReader("symbol,close\nEURUSD,1.1\nGBPUSD,1.3\n,1.2\nEURUSD,1.0\n")
+
+	df, err := csv.Load(context.Background(), reader, map[string]csv.ConverterAny{
+		"symbol": csv.Categorical,
+		"close":  csv.Float64,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := df.Series[df.MustNameToColumn("symbol")].(*dataframe.Categorical)
+
+	if c.NRows() != 4 || len(c.Categories()) != 2 || c.ValueAny(2) != nil || c.ValueAny(3) != "EURUSD" {
+		t.Fatalf(`csv.Load(...) symbol = %v, want match for [EURUSD GBPUSD NaN EURUSD]`, c)
+	}
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestConcatRows(t *testing.T) {
+	ctx := context.Background()
+
+	df1 := dataframe.NewDataFrame(
+		dataframe.NewSeries("a", nil, 1., 2.),
+		dataframe.NewSeries("b", nil, "x", "y"),
+	)
+	df2 := dataframe.NewDataFrame(
+		dataframe.NewSeries("b", nil, "z"),
+		dataframe.NewSeries("a", nil, 3.),
+	)
+
+	df, err := dataframe.Concat(ctx, dataframe.ROWS, df1, df2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.NRows() != 3 || df.Row(2)["a"] != 3. || df.Row(2)["b"] != "z" || df.Names()[0] != "a" {
+		t.Fatalf(`Concat(ctx, ROWS, df1, df2) = %v, want match for a: [1 2 3], b: [x y z]`, df)
+	}
+
+	if df1.NRows() != 2 {
+		t.Fatalf(`df1.NRows() = %v, want match for 2`, df1.NRows())
+	}
+
+	df3 := dataframe.NewDataFrame(dataframe.NewSeries("a", nil, 4.), dataframe.NewSeries("c", nil, 1))
+
+	if _, err := dataframe.Concat(ctx, dataframe.ROWS, df1, df3); err == nil {
+		t.Fatalf(`Concat(ctx, ROWS, df1, df3) returned <nil>, want match for error`)
+	}
+
+	df4 := dataframe.NewDataFrame(dataframe.NewSeries("a", nil, 4), dataframe.NewSeries("b", nil, "w"))
+
+	if _, err := dataframe.Concat(ctx, dataframe.ROWS, df1, df4); err == nil {
+		t.Fatalf(`Concat(ctx, ROWS, df1, df4) with different types returned <nil>, want match for error`)
+	}
+
+	df, err = dataframe.ConcatWithOptions(ctx, dataframe.ROWS, []*dataframe.DataFrame{df1, df3}, dataframe.ConcatOptions{Union: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.NRows() != 3 || len(df.Series) != 3 {
+		t.Fatalf(`ConcatWithOptions(...) = %v, want match for 3x3 dataframe`, df)
+	}
+
+	if df.Row(2)["b"] != "" || df.Row(0)["c"] != 0 || df.Row(2)["c"] != 1 || df.Row(2)["a"] != 4. {
+		t.Fatalf(`ConcatWithOptions(...) = %v, unexpected values`, df)
+	}
+
+	df5 := dataframe.NewDataFrame(dataframe.NewSeries("c", nil, 1.))
+	df, _ = dataframe.ConcatWithOptions(ctx, dataframe.ROWS, []*dataframe.DataFrame{df1, df5}, dataframe.ConcatOptions{Union: true})
+
+	if !math.IsNaN(df.Row(0)["c"].(float64)) {
+		t.Fatalf(`df.Row(0)["c"] = %v, want match for NaN`, df.Row(0)["c"])
+	}
+}
+
+func TestConcatColumns(t *testing.T) {
+	ctx := context.Background()
+
+	df1 := dataframe.NewDataFrame(dataframe.NewSeries("a", nil, 1., 2.))
+	df2 := dataframe.NewDataFrame(dataframe.NewSeries("b", nil, "x", "y"))
+
+	df, err := dataframe.Concat(ctx, dataframe.COLUMNS, df1, df2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.NRows() != 2 || df.Row(1)["b"] != "y" {
+		t.Fatalf(`Concat(ctx, COLUMNS, df1, df2) = %v, want match for a: [1 2], b: [x y]`, df)
+	}
+
+	df.Update(0, "a", 10.)
+	if df1.Row(0)["a"] != 1. {
+		t.Fatalf(`df1.Row(0)["a"] = %v, want match for 1`, df1.Row(0)["a"])
+	}
+
+	if _, err := dataframe.Concat(ctx, dataframe.COLUMNS, df1, df1); err == nil {
+		t.Fatalf(`Concat(ctx, COLUMNS, df1, df1) returned <nil>, want match for error`)
+	}
+
+	df3 := dataframe.NewDataFrame(dataframe.NewSeries("c", nil, 1.))
+	if _, err := dataframe.Concat(ctx, dataframe.COLUMNS, df1, df3); err == nil {
+		t.Fatalf(`Concat(ctx, COLUMNS, df1, df3) returned <nil>, want match for error`)
+	}
+}
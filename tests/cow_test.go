@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesCopyOnWrite(t *testing.T) {
+	s := dataframe.NewSeries("x", &dataframe.SeriesInit{Capacity: 10}, 3, 1, 2)
+	s.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+
+	c := s.Copy()
+	if &c.Values[0] != &s.Values[0] {
+		t.Fatalf(`s.Copy() copied values, want match for shared values`)
+	}
+
+	// appending to the series does not change the copy
+	s.Append([]int{4})
+	c.Sort(context.Background())
+
+	if !reflect.DeepEqual(s.Values, []int{3, 1, 2, 4}) || !reflect.DeepEqual(c.Values, []int{1, 2, 3}) {
+		t.Fatalf(`s = %v, c = %v, want match for [3 1 2 4] and [1 2 3]`, s.Values, c.Values)
+	}
+
+	c2 := s.Copy(dataframe.Range(1, 2))
+	s.Update(1, 10)
+	s.Swap(0, 2)
+	c2.Append([]int{5})
+
+	if !reflect.DeepEqual(s.Values, []int{2, 10, 3, 4}) || !reflect.DeepEqual(c2.Values, []int{1, 2, 5}) {
+		t.Fatalf(`s = %v, c2 = %v, want match for [2 10 3 4] and [1 2 5]`, s.Values, c2.Values)
+	}
+
+	// views are not copied on write, their copies are
+	view := s.Head(2)
+	vc := view.Copy()
+	s.Update(0, 20)
+
+	if view.Value(0) != 20 || vc.Value(0) != 2 {
+		t.Fatalf(`s.Head(2) = %v, copy = %v, want match for [20 10] and [2 10]`, view.Values, vc.Values)
+	}
+}
+
+func TestDataFrameCopyOnWrite(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3.),
+		dataframe.NewCategorical("side", nil, "buy", "sell", "buy"),
+	)
+
+	c := df.Copy()
+	c.UpdateRow(0, []any{10., "hold"})
+	df.Remove(2)
+
+	if c.NRows() != 3 || c.Row(2)["close"] != 3. || c.Row(0)["side"] != "hold" {
+		t.Fatalf(`c = %v, want match for [10 2 3]`, c)
+	}
+
+	if df.NRows() != 2 || df.Row(0)["close"] != 1. || df.Row(0)["side"] != "buy" {
+		t.Fatalf(`df = %v, want match for [1 2]`, df)
+	}
+}
@@ -58,6 +58,28 @@ func TestCSVLoad(t *testing.T) {
 	}
 }
 
+type csvSide string
+
+func TestCSVLoadRegisteredType(t *testing.T) {
+	dataframe.RegisterType(dataframe.TypeOptions[csvSide]{
+		IsLessThanFunc: func(a, b csvSide) bool { return a < b },
+		ValueFormatter: func(v any) string { return "side " + string(v.(csvSide)) },
+	})
+
+	df, err := csv.Load(context.Background(), strings.NewReader("side\nsell\nbuy\n"), map[string]csv.ConverterAny{
+		"side": csv.NewConverter(func(s string) csvSide { return csvSide(s) }),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// series of registered types get comparators and formatter of the type
+	side := dataframe.GetSeries[csvSide](df, "side")
+	if !side.Sort(context.Background()) || side.ValueString(0) != "side buy" {
+		t.Fatalf(`side = %v, want match for sorted [side buy, side sell]`, side)
+	}
+}
+
 func TestCSVExport(t *testing.T) {
 	ctx := context.Background()
 
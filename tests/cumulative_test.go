@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestCumulative(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 1., 3., math.NaN(), 2.)
+
+	if c := dataframe.CumSum(s); c.Values[1] != 4. || !math.IsNaN(c.Values[2]) || !math.IsNaN(c.Values[3]) {
+		t.Fatalf(`CumSum(s) = %v, want match for [1 4 NaN NaN]`, c.Values)
+	}
+
+	if c := dataframe.CumSum(s, dataframe.CumulativeOptions{SkipNaN: true}); !math.IsNaN(c.Values[2]) || c.Values[3] != 6. {
+		t.Fatalf(`CumSum(s, SkipNaN) = %v, want match for [1 4 NaN 6]`, c.Values)
+	}
+
+	if c := dataframe.CumMax(s, dataframe.CumulativeOptions{SkipNaN: true}); c.Values[3] != 3. {
+		t.Fatalf(`CumMax(s, SkipNaN) = %v, want match for [1 3 NaN 3]`, c.Values)
+	}
+
+	if c := dataframe.CumProd(dataframe.NewSeries("y", nil, 2, 3, 4)); c.Values[2] != 24 {
+		t.Fatalf(`CumProd(y) = %v, want match for [2 6 24]`, c.Values)
+	}
+
+	if c := dataframe.CumCount(s); c.Values[3] != 3 {
+		t.Fatalf(`CumCount(s) = %v, want match for [1 2 2 3]`, c.Values)
+	}
+}
+
+func TestExpanding(t *testing.T) {
+	e := dataframe.NewExpanding(dataframe.NewSeries("x", nil, 1., 2., math.NaN(), 6.), dataframe.CumulativeOptions{SkipNaN: true})
+
+	if m := e.Mean(); m.Values[1] != 1.5 || m.Values[2] != 1.5 || m.Values[3] != 3. {
+		t.Fatalf(`e.Mean() = %v, want match for [1 1.5 1.5 3]`, m.Values)
+	}
+
+	if s := e.Std(); !math.IsNaN(s.Values[0]) || math.Abs(s.Values[1]-math.Sqrt(0.5)) > 1e-12 {
+		t.Fatalf(`e.Std() = %v, want match for [NaN 0.707...]`, s.Values)
+	}
+
+	if q := e.Quantile(0.5); q.Values[3] != 2. {
+		t.Fatalf(`e.Quantile(0.5) = %v, want match for [1 1.5 1.5 2]`, q.Values)
+	}
+
+	if r := e.Reduce(func(vals []float64) float64 { return float64(len(vals)) }); r.Values[3] != 3. {
+		t.Fatalf(`e.Reduce(len) = %v, want match for [1 2 2 3]`, r.Values)
+	}
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/csv"
+	"github.com/tradeoforigin/dataframe-go/utils/decimal"
+)
+
+func TestDecimal(t *testing.T) {
+	a, b := decimal.MustParse("0.1"), decimal.MustParse("0.20")
+
+	if sum := a.Add(b); sum.String() != "0.30" || !sum.Equal(decimal.MustParse("0.3")) {
+		t.Fatalf(`0.1 + 0.20 = %v, want match for 0.30`, sum)
+	}
+
+	if d := decimal.MustParse("-1.5").Mul(decimal.FromInt(3)); d.String() != "-4.5" {
+		t.Fatalf(`-1.5 * 3 = %v, want match for -4.5`, d)
+	}
+
+	if d := decimal.FromInt(2).Div(decimal.FromInt(3), 4, decimal.HALF_UP); d.String() != "0.6667" {
+		t.Fatalf(`2 / 3 = %v, want match for 0.6667`, d)
+	}
+
+	for mode, want := range map[decimal.RoundingMode]string{
+		decimal.HALF_EVEN: "-2.24",
+		decimal.HALF_UP:   "-2.25",
+		decimal.HALF_DOWN: "-2.24",
+		decimal.UP:        "-2.25",
+		decimal.DOWN:      "-2.24",
+		decimal.CEILING:   "-2.24",
+		decimal.FLOOR:     "-2.25",
+	} {
+		if d := decimal.MustParse("-2.245").Rescale(2, mode); d.String() != want {
+			t.Fatalf(`-2.245.Rescale(2, %v) = %v, want match for %v`, mode, d, want)
+		}
+	}
+
+	if d := decimal.FromFloat(1.005, 2, decimal.HALF_UP); d.String() != "1.01" {
+		t.Fatalf(`FromFloat(1.005, 2, HALF_UP) = %v, want match for 1.01`, d)
+	}
+
+	min := decimal.New(math.MinInt64, 0)
+
+	if d := decimal.FromInt(-1).Sub(min); d.Mantissa() != math.MaxInt64 {
+		t.Fatalf(`-1 - MinInt64 = %v, want match for %v`, d, int64(math.MaxInt64))
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf(`0 - MinInt64 did not panic, want match for overflow`)
+			}
+		}()
+
+		decimal.FromInt(0).Sub(min)
+	}()
+
+	if _, err := decimal.Parse("1.2.3"); err == nil {
+		t.Fatalf(`Parse("1.2.3") returned <nil>, want match for error`)
+	}
+}
+
+func TestDecimalSeries(t *testing.T) {
+	reader := strings.NewReader("pnl\n0.10\n-0.05\n0.2\n")
+
+	df, err := csv.Load(context.Background(), reader, map[string]csv.ConverterAny{"pnl": csv.Decimal})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := dataframe.GetSeries[decimal.Decimal](df, "pnl")
+
+	if sum := decimal.Sum(s); sum.String() != "0.25" {
+		t.Fatalf(`Sum(pnl) = %v, want match for 0.25`, sum)
+	}
+
+	s.Sort(context.Background())
+
+	if s.ValueString(0) != "-0.05" || s.ValueString(2) != "0.2" {
+		t.Fatalf(`pnl.Sort(ctx) = %v, want match for [-0.05 0.10 0.2]`, s)
+	}
+}
+
+func TestDecimalKeys(t *testing.T) {
+	s := decimal.NewSeries("price", nil, decimal.MustParse("1.0"), decimal.MustParse("1.00"), decimal.MustParse("2"))
+
+	if n := s.NUnique(); n != 2 {
+		t.Fatalf(`price.NUnique() = %v, want match for 2`, n)
+	}
+
+	df := dataframe.NewDataFrame(s)
+	if err := df.SetIndex("price"); err != nil {
+		t.Fatal(err)
+	}
+
+	if rows := df.LocRows(decimal.MustParse("1")); len(rows) != 2 || rows[0] != 0 || rows[1] != 1 {
+		t.Fatalf(`df.LocRows(1) = %v, want match for [0 1]`, rows)
+	}
+}
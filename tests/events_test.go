@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesSubscribe(t *testing.T) {
+	s := dataframe.NewSeries("x", &dataframe.SeriesInit{MaxLen: 3}, 3, 1)
+	s.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+
+	var kinds []dataframe.EventKind
+	var last int
+
+	sub := s.Subscribe(func(ev dataframe.Event[int]) {
+		kinds = append(kinds, ev.Kind)
+		// the lock is released before delivery
+		if s.NRows() > 0 {
+			last = s.Value(-1)
+		}
+	})
+
+	s.Append([]int{2})
+	s.Append([]int{5})
+	s.Update(0, 4)
+	s.Sort(context.Background())
+	s.Remove(0)
+	s.Reset()
+
+	want := []dataframe.EventKind{
+		dataframe.EVENT_APPEND, dataframe.EVENT_EVICT, dataframe.EVENT_APPEND,
+		dataframe.EVENT_UPDATE, dataframe.EVENT_SORT, dataframe.EVENT_REMOVE, dataframe.EVENT_RESET,
+	}
+
+	if !reflect.DeepEqual(kinds, want) || last != 5 {
+		t.Fatalf(`events = %v, want match for %v`, kinds, want)
+	}
+
+	sub.Unsubscribe()
+	s.Append([]int{1})
+
+	if len(kinds) != len(want) {
+		t.Fatalf(`events after Unsubscribe = %v, want match for %v`, kinds, want)
+	}
+}
+
+func TestDataFrameSubscribe(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries[float64]("price", nil),
+	)
+
+	events := make(chan dataframe.Event[map[string]any], 10)
+
+	sub := df.Subscribe(func(ev dataframe.Event[map[string]any]) {
+		events <- ev
+	}, dataframe.SubscribeOptions{Buffer: 10})
+	defer sub.Unsubscribe()
+
+	df.Append([]any{1.5})
+	df.UpdateRow(0, map[string]any{"price": 2.5})
+	df.Remove(0)
+
+	for _, want := range []dataframe.Event[map[string]any]{
+		{Kind: dataframe.EVENT_APPEND, Count: 1, Values: []map[string]any{{"price": 1.5}}},
+		{Kind: dataframe.EVENT_UPDATE, Count: 1, Values: []map[string]any{{"price": 2.5}}},
+		{Kind: dataframe.EVENT_REMOVE, Count: 1, Values: []map[string]any{{"price": 2.5}}},
+	} {
+		if ev := <-events; !reflect.DeepEqual(ev, want) {
+			t.Fatalf(`event = %v, want match for %v`, ev, want)
+		}
+	}
+}
+
+func TestSeriesSubscribeDataFrameSort(t *testing.T) {
+	price := dataframe.NewSeries("price", nil, 3., 1., 2.)
+	side := dataframe.NewSeries("side", nil, "c", "a", "b")
+	side.SetIsLessThanFunc(dataframe.IsLessThanFunc[string])
+
+	df := dataframe.NewDataFrame(price, side)
+
+	var kinds []dataframe.EventKind
+	var first float64
+
+	sub := price.Subscribe(func(ev dataframe.Event[float64]) {
+		kinds = append(kinds, ev.Kind)
+		// the DataFrame is unlocked before delivery
+		if df.NRows() > 0 {
+			first = price.Value(0)
+		}
+	})
+	defer sub.Unsubscribe()
+
+	df.Sort(context.Background(), []dataframe.SortKey{{Key: "side"}})
+
+	if len(kinds) != 1 || kinds[0] != dataframe.EVENT_SORT || first != 1. {
+		t.Fatalf(`events = %v, first = %v, want match for [sort], 1`, kinds, first)
+	}
+
+	price.Swap(0, 2)
+
+	if len(kinds) != 2 || kinds[1] != dataframe.EVENT_SORT || first != 3. {
+		t.Fatalf(`events = %v, first = %v, want match for [sort sort], 3`, kinds, first)
+	}
+}
+
+func TestSubscribeBackpressure(t *testing.T) {
+	s := dataframe.NewSeries[int]("x", nil)
+
+	block := make(chan struct{})
+	sub := s.Subscribe(func(ev dataframe.Event[int]) {
+		<-block
+	}, dataframe.SubscribeOptions{Buffer: 1, Backpressure: dataframe.DROP_NEWEST})
+
+	for i := 0; i < 10; i++ {
+		s.Append([]int{i})
+	}
+
+	if d := sub.Dropped(); d < 8 {
+		t.Fatalf(`sub.Dropped() = %v, want match for at least 8`, d)
+	}
+
+	close(block)
+	sub.Unsubscribe()
+}
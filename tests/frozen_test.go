@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestFreeze(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3.),
+		dataframe.NewCategorical("side", nil, "buy", "sell", "buy"),
+	)
+
+	frozen := df.Freeze()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for it := frozen.Iterator(); it.Next(); {
+				_ = frozen.Value(it.Index, "close")
+			}
+		}()
+	}
+
+	// changes of the DataFrame do not change the snapshot
+	df.Update(0, "close", 10.)
+	df.Append([]any{4., "sell"})
+	wg.Wait()
+
+	if frozen.NRows() != 3 || frozen.Value(0, "close") != 1. || frozen.Value(-1, 1) != "buy" {
+		t.Fatalf(`frozen = %v, want match for [1 2 3]`, frozen)
+	}
+
+	if err := frozen.Append([]any{4., "sell"}); !errors.Is(err, dataframe.ErrFrozen) {
+		t.Fatalf(`frozen.Append(...) = %v, want match for ErrFrozen`, err)
+	}
+}
+
+func TestThaw(t *testing.T) {
+	frozen := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3.),
+		dataframe.NewCategorical("side", nil, "buy", "sell", "buy"),
+	).Freeze()
+
+	a, b := frozen.Thaw(), frozen.Thaw()
+
+	a.Update(0, "close", 10.)
+	a.Update(0, "side", "hold")
+	b.Remove(0)
+	b.Append([]any{4., "sell"})
+
+	if frozen.Value(0, "close") != 1. || frozen.Value(0, "side") != "buy" || frozen.NRows() != 3 {
+		t.Fatalf(`frozen = %v, want match for unchanged snapshot`, frozen)
+	}
+
+	if a.Row(0)["close"] != 10. || a.Row(0)["side"] != "hold" || a.Row(1)["close"] != 2. {
+		t.Fatalf(`a = %v, want match for [10 2 3]`, a)
+	}
+
+	if b.NRows() != 3 || b.Row(0)["close"] != 2. || b.Row(2)["side"] != "sell" {
+		t.Fatalf(`b = %v, want match for [2 3 4]`, b)
+	}
+}
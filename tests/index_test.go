@@ -0,0 +1,118 @@
+package tests
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestIndexLoc(t *testing.T) {
+	x := dataframe.NewSeries("x", nil, 1., 2., 3.)
+	x.SetIsLessThanFunc(dataframe.IsLessThanFunc[float64])
+
+	df := dataframe.NewDataFrame(dataframe.NewSeries("id", nil, "a", "b", "c"), x)
+
+	if err := df.SetIndex("id"); err != nil {
+		t.Fatal(err)
+	}
+
+	if row := df.Loc("b"); row == nil || row["x"] != 2. {
+		t.Fatalf(`df.Loc("b") = %v, want match for map[id:b x:2]`, row)
+	}
+
+	df.Append([]any{"b", 4.})
+
+	if rows := df.LocRows("b"); len(rows) != 2 || rows[0] != 1 || rows[1] != 3 {
+		t.Fatalf(`df.LocRows("b") = %v, want match for [1 3]`, rows)
+	}
+
+	df.Remove(0)
+
+	if rows := df.LocRows("b"); len(rows) != 2 || rows[0] != 0 || rows[1] != 2 {
+		t.Fatalf(`df.LocRows("b") = %v, want match for [0 2]`, rows)
+	}
+
+	df.Sort(context.Background(), []dataframe.SortKey{{Key: "x", Desc: true}})
+
+	if row := df.Loc("c"); row == nil || row["x"] != 3. {
+		t.Fatalf(`df.Loc("c") = %v, want match for map[id:c x:3]`, row)
+	}
+
+	if row := df.Loc("z"); row != nil {
+		t.Fatalf(`df.Loc("z") = %v, want match for <nil>`, row)
+	}
+}
+
+func TestIndexLocRange(t *testing.T) {
+	k := dataframe.NewSeries("k", nil, 5, 1, 3, 4, 2)
+	k.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+
+	df := dataframe.NewDataFrame(k, dataframe.NewSeries("v", nil, "e", "a", "c", "d", "b"))
+
+	if err := df.SetIndex("k", dataframe.IndexOptions{Type: dataframe.SORTED}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := df.LocRange(2, 4)
+
+	if r.NRows() != 3 || r.Row(0)["v"] != "b" || r.Row(2)["v"] != "d" {
+		t.Fatalf(`df.LocRange(2, 4) = %v, want match for v: [b c d]`, r)
+	}
+
+	// appended rows are added to the index, updated rows rebuild it
+	df.Append([]any{3, "f"})
+	df.Update(0, "k", 3)
+
+	if rows := df.LocRows(3); !reflect.DeepEqual(rows, []int{0, 2, 5}) {
+		t.Fatalf(`df.LocRows(3) = %v, want match for [0 2 5]`, rows)
+	}
+
+	if r.IndexName() != "k" {
+		t.Fatalf(`r.IndexName() = %v, want match for k`, r.IndexName())
+	}
+
+	if err := df.SetIndex("unknown"); err == nil {
+		t.Fatalf(`df.SetIndex("unknown") returned <nil>, want match for error`)
+	}
+}
+
+func TestIndexMaintained(t *testing.T) {
+	for _, typ := range []dataframe.IndexType{dataframe.HASH, dataframe.SORTED} {
+		k := dataframe.NewSeries[int]("k", nil)
+		k.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+
+		df := dataframe.NewDataFrame(k, dataframe.NewSeries[int]("v", nil))
+		df.SetMaxLen(20)
+
+		if err := df.SetIndex("k", dataframe.IndexOptions{Type: typ}); err != nil {
+			t.Fatal(err)
+		}
+
+		// streaming pattern: capped appends, removal of the first row and updates
+		for i := 0; i < 200; i++ {
+			df.Append([]any{i % 7, i})
+
+			switch {
+			case i%5 == 0:
+				df.Remove(0)
+			case i%3 == 0:
+				df.Update(df.NRows()-1, "k", i%4)
+			}
+
+			for key := 0; key < 7; key++ {
+				want := []int{}
+				for row := 0; row < df.NRows(); row++ {
+					if df.Row(row)["k"] == key {
+						want = append(want, row)
+					}
+				}
+
+				if rows := df.LocRows(key); !reflect.DeepEqual(rows, want) {
+					t.Fatalf(`df.LocRows(%v) = %v, want match for %v (index %v, step %v)`, key, rows, want, typ, i)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/online"
+)
+
+func TestOnlineStatistics(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 2., 4., 4., 4., 5., 5., 7., 9.)
+
+	if v := online.Seed(online.NewVariance(0, 0), s); v != 4 {
+		t.Fatalf(`Variance(0, 0) = %v, want match for 4`, v)
+	}
+
+	if v := online.Seed(online.NewVariance(3, 1), s); math.Abs(v - 4) > 1e-12 {
+		t.Fatalf(`Variance(3, 1) = %v, want match for 4`, v)
+	}
+
+	if m := online.Seed(online.NewMean(2), s); m != 8 {
+		t.Fatalf(`Mean(2) = %v, want match for 8`, m)
+	}
+
+	if e := online.Seed(online.NewEMA(3), s); math.Abs(e - 89. / 12) > 1e-12 {
+		t.Fatalf(`EMA(3) = %v, want match for 7.41(6)`, e)
+	}
+
+	max := online.NewRollingMax(3)
+	for i, want := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		if v := max.Update(s.Values[i]); v != want {
+			t.Fatalf(`RollingMax(3).Update(%v) = %v, want match for %v`, s.Values[i], v, want)
+		}
+	}
+
+	if r := online.Seed(online.NewRSI(3), s); r != 100 {
+		t.Fatalf(`RSI(3) = %v, want match for 100`, r)
+	}
+
+	atr := online.NewATR(2)
+	atr.UpdateBar(3, 1, 2)
+	if a := atr.UpdateBar(6, 4, 5); a != 3 {
+		t.Fatalf(`ATR(2) = %v, want match for 3`, a)
+	}
+}
+
+func TestOnlineAttach(t *testing.T) {
+	s := dataframe.NewSeries("close", nil, 1., 2., 3.)
+
+	mean, sub := online.Attach(online.NewMean(2), s, "mean")
+	defer sub.Unsubscribe()
+
+	if mean.NRows() != 3 || mean.Value(-1) != 2.5 {
+		t.Fatalf(`Attach(Mean(2)) = %v, want match for [1 1.5 2.5]`, mean.Values)
+	}
+
+	s.Append([]float64{5})
+
+	if mean.NRows() != 4 || mean.Value(-1) != 4 {
+		t.Fatalf(`Attach(Mean(2)) after Append = %v, want match for [1 1.5 2.5 4]`, mean.Values)
+	}
+
+	s.Update(0, 3)
+
+	if mean.NRows() != 4 || mean.Value(1) != 2.5 {
+		t.Fatalf(`Attach(Mean(2)) after Update = %v, want match for [3 2.5 2.5 4]`, mean.Values)
+	}
+
+	s.SetIsLessThanFunc(dataframe.IsLessThanFunc[float64])
+	df := dataframe.NewDataFrame(s)
+
+	if !df.Sort(context.Background(), []dataframe.SortKey{{Key: "close", Desc: true}}) {
+		t.Fatalf(`df.Sort(ctx, close desc) = false, want match for true`)
+	}
+
+	if mean.NRows() != 4 || mean.Value(0) != 5 || mean.Value(-1) != 2.5 {
+		t.Fatalf(`Attach(Mean(2)) after df.Sort = %v, want match for [5 4 3 2.5]`, mean.Values)
+	}
+}
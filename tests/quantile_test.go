@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestQuantile(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 4., 1., math.NaN(), 3., 2.)
+
+	if q := dataframe.Quantile(s, 0, 0.5, 0.25, 1); q[0] != 1. || q[1] != 2.5 || q[2] != 1.75 || q[3] != 4. {
+		t.Fatalf(`Quantile(s, 0, 0.5, 0.25, 1) = %v, want match for [1 2.5 1.75 4]`, q)
+	}
+
+	for method, want := range map[dataframe.QuantileMethod]float64{
+		dataframe.LOWER:    2.,
+		dataframe.HIGHER:   3.,
+		dataframe.NEAREST:  3.,
+		dataframe.MIDPOINT: 2.5,
+	} {
+		if q := dataframe.QuantileWithOptions(s, []float64{0.5}, dataframe.QuantileOptions{Method: method}); q[0] != want {
+			t.Fatalf(`QuantileWithOptions(s, [0.5], %v) = %v, want match for %v`, method, q, want)
+		}
+	}
+}
+
+func TestQuantileValues(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, "d", "a", "c", "b")
+	s.SetIsLessThanFunc(dataframe.IsLessThanFunc[string])
+
+	for method, want := range map[dataframe.QuantileMethod]string{
+		dataframe.LOWER:   "b",
+		dataframe.HIGHER:  "c",
+		dataframe.NEAREST: "c",
+	} {
+		if q := dataframe.QuantileValues(s, []float64{0, 0.5, 1}, dataframe.QuantileOptions{Method: method}); q[0] != "a" || q[1] != want || q[2] != "d" {
+			t.Fatalf(`QuantileValues(s, [0 0.5 1], %v) = %v, want match for [a %v d]`, method, q, want)
+		}
+	}
+}
+
+func TestRank(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 3., 1., 3., math.NaN(), 2.)
+	s.SetIsLessThanFunc(dataframe.IsLessThanFunc[float64])
+
+	for method, want := range map[dataframe.RankMethod][]float64{
+		dataframe.AVERAGE: {3.5, 1, 3.5, math.NaN(), 2},
+		dataframe.MIN:     {3, 1, 3, math.NaN(), 2},
+		dataframe.MAX:     {4, 1, 4, math.NaN(), 2},
+		dataframe.DENSE:   {3, 1, 3, math.NaN(), 2},
+		dataframe.FIRST:   {3, 1, 4, math.NaN(), 2},
+	} {
+		r := dataframe.Rank(s, dataframe.RankOptions{Method: method})
+		if eq, _ := r.IsEqual(context.Background(), dataframe.NewSeries("x", nil, want...)); !eq {
+			t.Fatalf(`Rank(s, %v) = %v, want match for %v`, method, r.Values, want)
+		}
+	}
+
+	// numeric series are ordered naturally without IsLessThanFunc
+	if r := dataframe.Rank(dataframe.NewSeries("y", nil, 3, 1, 2)); r.Values[0] != 3 || r.Values[1] != 1 {
+		t.Fatalf(`Rank(y) = %v, want match for [3 1 2]`, r.Values)
+	}
+
+	if r := dataframe.Rank(s, dataframe.RankOptions{Pct: true, Desc: true}); r.Values[1] != 1. || r.Values[0] != 0.375 {
+		t.Fatalf(`Rank(s, Pct, Desc) = %v, want match for [0.375 1 0.375 NaN 0.75]`, r.Values)
+	}
+}
+
+func TestCut(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 0., 1., 1.5, 3., math.NaN())
+
+	c, err := dataframe.Cut(s, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.ValueAny(0) != nil || c.ValueAny(1) != "(0, 1]" || c.ValueAny(2) != "(1, 2]" || c.ValueAny(3) != nil || c.ValueAny(4) != nil {
+		t.Fatalf(`Cut(s, [0 1 2]) = %v, want match for [NaN (0, 1] (1, 2] NaN NaN]`, c)
+	}
+
+	q, err := dataframe.QCut(s, []float64{0, 0.5, 1}, dataframe.CutOptions{Labels: []string{"low", "high"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if q.ValueAny(0) != "low" || q.ValueAny(1) != "low" || q.ValueAny(2) != "high" || q.ValueAny(3) != "high" || !q.Ordered() {
+		t.Fatalf(`QCut(s, [0 0.5 1]) = %v, want match for [low low high high NaN]`, q)
+	}
+
+	if _, err := dataframe.Cut(s, []float64{1, 0}); err == nil {
+		t.Fatalf(`Cut(s, [1 0]) returned <nil>, want match for error`)
+	}
+}
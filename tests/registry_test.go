@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+type registryDog struct {
+	Name string
+}
+
+func TestRegistryNewSeriesFromType(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := dataframe.NewSeriesFromType("float64", "x", &dataframe.SeriesInit{Size: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, ok := s.(*dataframe.Series[float64])
+	if !ok || x.NRows() != 2 {
+		t.Fatalf(`NewSeriesFromType("float64", ...) = %T with %v rows, want match for *Series[float64] with 2 rows`, s, s.NRows())
+	}
+
+	x.Update(0, 2)
+	x.Update(1, 1)
+	if !x.Sort(ctx) || x.Value(0) != 1 {
+		t.Fatalf(`x.Sort(ctx) did not use registered IsLessThanFunc, x = %v`, x)
+	}
+
+	if name := dataframe.TypeName[*time.Time](); name != "*time.Time" || dataframe.TypeName[any]() != "any" {
+		t.Fatalf(`TypeName[*time.Time]() = %v, want match for *time.Time`, name)
+	}
+
+	c, err := dataframe.NewSeriesFromType("categorical", "side", &dataframe.SeriesInit{MaxLen: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.AppendAny([]string{"buy", "sell", "hold"})
+	if c.NRows() != 2 || c.MaxLen() != 2 || c.ValueAny(0) != "sell" {
+		t.Fatalf(`NewSeriesFromType("categorical", MaxLen: 2) = %v, want match for [sell hold]`, c)
+	}
+
+	typeName := dataframe.RegisterType(dataframe.TypeOptions[registryDog]{
+		IsLessThanFunc: func(a, b registryDog) bool { return a.Name < b.Name },
+		ValueFormatter: func(v any) string { return "dog " + v.(registryDog).Name },
+	})
+
+	s, err = dataframe.NewSeriesFromType(typeName, "dogs", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.AppendAny([]registryDog{{"Rex"}, {"Abby"}})
+	s.Sort(ctx)
+
+	if s.ValueString(0) != "dog Abby" {
+		t.Fatalf(`s.ValueString(0) = %v, want match for dog Abby`, s.ValueString(0))
+	}
+
+	if _, err := dataframe.NewSeriesFromType("main.Unknown", "x", nil); err == nil {
+		t.Fatalf(`NewSeriesFromType("main.Unknown", ...) returned <nil>, want match for error`)
+	}
+}
+
+func TestDataFrameFromSchema(t *testing.T) {
+	df1 := dataframe.NewDataFrame(
+		dataframe.NewSeries("time", nil, time.Now()),
+		dataframe.NewSeries("price", nil, 1.5),
+		dataframe.NewSeries("symbol", nil, "EURUSD"),
+	)
+
+	df2, err := dataframe.NewDataFrameFromSchema(df1.Schema(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	df2.Append(df1.Row(0))
+
+	for i, field := range df2.Schema() {
+		if field != df1.Schema()[i] {
+			t.Fatalf(`df2.Schema()[%d] = %v, want match for %v`, i, field, df1.Schema()[i])
+		}
+	}
+
+	if df2.NRows() != 1 {
+		t.Fatalf(`df2.NRows() = %v, want match for 1`, df2.NRows())
+	}
+
+	_, err = dataframe.NewDataFrameFromSchema([]dataframe.SchemaField{{Name: "a", Type: "int"}, {Name: "a", Type: "int"}}, nil)
+	if err == nil {
+		t.Fatalf(`NewDataFrameFromSchema(...) with duplicate names returned <nil>, want match for error`)
+	}
+}
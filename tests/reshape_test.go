@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestPivotMelt(t *testing.T) {
+	ctx := context.Background()
+
+	day := dataframe.NewSeries("day", nil, 2, 1, 1, 2, 3)
+	symbol := dataframe.NewSeries("symbol", nil, "GBPUSD", "EURUSD", "GBPUSD", "EURUSD", "EURUSD")
+	close := dataframe.NewSeries("close", nil, 1.3, 1.1, 1.2, 1.0, 0.9)
+
+	day.SetIsLessThanFunc(dataframe.IsLessThanFunc[int])
+	symbol.SetIsLessThanFunc(dataframe.IsLessThanFunc[string])
+
+	df := dataframe.NewDataFrame(day, symbol, close)
+
+	wide, err := dataframe.Pivot(ctx, df, "day", "symbol", "close", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := wide.Names()
+	if len(names) != 3 || names[0] != "day" || names[1] != "EURUSD" || names[2] != "GBPUSD" {
+		t.Fatalf(`wide.Names() = %v, want match for [day EURUSD GBPUSD]`, names)
+	}
+
+	if wide.NRows() != 3 || wide.Row(0)["day"] != 1 || wide.Row(1)["GBPUSD"] != 1.3 {
+		t.Fatalf(`Pivot(...) = %v, unexpected values`, wide)
+	}
+
+	if !math.IsNaN(wide.Row(2)["GBPUSD"].(float64)) {
+		t.Fatalf(`wide.Row(2)["GBPUSD"] = %v, want match for NaN`, wide.Row(2)["GBPUSD"])
+	}
+
+	long, err := dataframe.Melt(ctx, wide, []string{"day"}, nil, dataframe.MeltOptions{VarName: "symbol", ValueName: "close"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if long.NRows() != 6 || long.Row(3)["symbol"] != "GBPUSD" || long.Row(3)["close"] != 1.2 || long.Series[2].Type() != "float64" {
+		t.Fatalf(`Melt(...) = %v, unexpected values`, long)
+	}
+
+	stacked, err := dataframe.Stack(ctx, wide, []string{"day"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stacked.Row(1)["variable"] != "GBPUSD" || stacked.Row(1)["day"] != 1 {
+		t.Fatalf(`Stack(...) = %v, unexpected values`, stacked)
+	}
+
+	df.Append([]any{1, "EURUSD", 2.0})
+
+	if _, err := dataframe.Unstack(ctx, df, "day", "symbol", "close"); err == nil {
+		t.Fatalf(`Unstack(...) with duplicates returned <nil>, want match for error`)
+	}
+
+	sum := func(vals []any) any {
+		total := 0.
+		for _, v := range vals {
+			total += v.(float64)
+		}
+		return total
+	}
+
+	wide, err = dataframe.Pivot(ctx, df, "day", "symbol", "close", sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if wide.Row(0)["EURUSD"] != 3.1 {
+		t.Fatalf(`wide.Row(0)["EURUSD"] = %v, want match for 3.1`, wide.Row(0)["EURUSD"])
+	}
+}
@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesMaxLen(t *testing.T) {
+	s := dataframe.NewSeries("x", &dataframe.SeriesInit{MaxLen: 3}, 1, 2, 3, 4)
+
+	if !reflect.DeepEqual(s.Values, []int{2, 3, 4}) {
+		t.Fatalf(`NewSeries(MaxLen: 3, 1, 2, 3, 4) = %v, want match for [2 3 4]`, s.Values)
+	}
+
+	for i := 5; i <= 100; i++ {
+		s.Append([]int{i})
+	}
+
+	if s.NRows() != 3 || s.Value(0) != 98 || s.Value(-1) != 100 {
+		t.Fatalf(`s.Values = %v, want match for [98 99 100]`, s.Values)
+	}
+
+	s.Append([]int{101, 102, 103, 104})
+
+	var vals []int
+	for it := s.Iterator(); it.Next(); {
+		vals = append(vals, it.Value)
+	}
+
+	if !reflect.DeepEqual(vals, []int{102, 103, 104}) {
+		t.Fatalf(`s.Iterator() = %v, want match for [102 103 104]`, vals)
+	}
+
+	c := s.Copy()
+	c.Append([]int{105})
+
+	if c.MaxLen() != 3 || !reflect.DeepEqual(c.Values, []int{103, 104, 105}) || s.Value(0) != 102 {
+		t.Fatalf(`s.Copy().Append(105) = %v, want match for [103 104 105]`, c.Values)
+	}
+
+	s.SetMaxLen(2)
+	if !reflect.DeepEqual(s.Values, []int{103, 104}) {
+		t.Fatalf(`s.SetMaxLen(2) = %v, want match for [103 104]`, s.Values)
+	}
+}
+
+func TestDataFrameMaxLen(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries[float64]("bid", nil),
+		dataframe.NewSeries[int]("volume", nil),
+	)
+	df.SetMaxLen(2)
+
+	for i := 1; i <= 5; i++ {
+		df.Append(map[string]any{"bid": float64(i), "volume": i * 10})
+	}
+
+	if df.NRows() != 2 || df.Row(0)["bid"] != 4. || df.Row(1)["volume"] != 50 {
+		t.Fatalf(`df = %v, want match for last 2 rows`, df)
+	}
+
+	ask := dataframe.NewSeries("ask", nil, 4.5, 5.5)
+	if err := df.AddSeries(ask, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	df.Append([]any{6., 60, 6.5})
+
+	if ask.NRows() != 2 || ask.Value(0) != 5.5 || df.Row(0)["bid"] != 5. {
+		t.Fatalf(`df = %v, want match for rows 5 and 6`, df)
+	}
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesShift(t *testing.T) {
+	s := dataframe.NewSeries("x", nil, 1., 2., 4.)
+
+	if sh := s.Shift(1); !math.IsNaN(sh.Values[0]) || sh.Values[1] != 1. || sh.Values[2] != 2. {
+		t.Fatalf(`s.Shift(1) = %v, want match for [NaN 1 2]`, sh.Values)
+	}
+
+	if sh := s.Shift(-2); sh.Values[0] != 4. || !math.IsNaN(sh.Values[1]) {
+		t.Fatalf(`s.Shift(-2) = %v, want match for [4 NaN NaN]`, sh.Values)
+	}
+
+	if d := dataframe.Diff(s, 1); !math.IsNaN(d.Values[0]) || d.Values[1] != 1. || d.Values[2] != 2. {
+		t.Fatalf(`Diff(s, 1) = %v, want match for [NaN 1 2]`, d.Values)
+	}
+
+	if p := dataframe.PctChange(s, 1); p.Values[1] != 1. || p.Values[2] != 1. {
+		t.Fatalf(`PctChange(s, 1) = %v, want match for [NaN 1 1]`, p.Values)
+	}
+
+	if l := dataframe.LogReturn(s, 2); math.Abs(l.Values[2]-math.Log(4)) > 1e-12 {
+		t.Fatalf(`LogReturn(s, 2) = %v, want match for [NaN NaN %v]`, l.Values, math.Log(4))
+	}
+}
+
+func TestDataFrameLags(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3.),
+		dataframe.NewSeries("volume", nil, 10, 20, 30),
+	)
+
+	if err := df.Lags([]string{"close", "volume"}, []int{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	row := df.Row(2)
+	if len(df.Names()) != 6 || row["close_lag2"] != 1. || row["volume_lag1"] != 20 || df.Row(0)["volume_lag1"] != 0 {
+		t.Fatalf(`df.Lags(...) = %v, want match for close_lag1, close_lag2, volume_lag1, volume_lag2`, df)
+	}
+
+	if err := df.Lags([]string{"close"}, []int{1}); err == nil {
+		t.Fatalf(`df.Lags([close], [1]) returned <nil>, want match for error`)
+	}
+}
+
+func TestDataFrameLagsCapped(t *testing.T) {
+	df := dataframe.NewDataFrame(dataframe.NewSeries("close", nil, 1., 2., 3.))
+	df.SetMaxLen(3)
+
+	if err := df.Lags([]string{"close"}, []int{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	df.Append(map[string]any{"close": 4., "close_lag1": 3.})
+
+	lag := dataframe.GetSeries[float64](df, "close_lag1")
+	if lag.MaxLen() != 3 || lag.NRows() != 3 || lag.Value(0) != 1. || lag.Value(2) != 3. {
+		t.Fatalf(`close_lag1 = %v, want match for capped [1 2 3]`, lag)
+	}
+}
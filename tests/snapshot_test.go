@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/decimal"
+	"github.com/tradeoforigin/dataframe-go/utils/snapshot"
+)
+
+func TestSnapshotExportLoad(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2022, 6, 1, 12, 30, 0, 0, time.FixedZone("CET", 3600))
+	one, two := int64(1), int64(2)
+
+	s1 := dataframe.NewSeries("time", nil, ts, ts.Add(time.Hour), ts.Add(2*time.Hour))
+	s2 := dataframe.NewSeries("price", nil, 1.5, math.NaN(), -2.25)
+	s3 := dataframe.NewSeries("qty", nil, &one, nil, &two)
+	s4 := dataframe.NewSeries("symbol", nil, "EURUSD", "", "GBPUSD")
+	s5 := dataframe.NewSeries("buy", nil, true, false, true)
+
+	df1 := dataframe.NewDataFrame(s1, s2, s3, s4, s5)
+
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+
+		err := snapshot.Export(ctx, &buf, df1, snapshot.ExportOptions{Compress: compress})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		df2, err := snapshot.Load(ctx, bytes.NewReader(buf.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if eq, err := df1.IsEqual(ctx, df2, dataframe.IsEqualOptions{CheckName: true}); !eq || err != nil {
+			t.Fatalf(`eq, err := df1.IsEqual(ctx, df2) = %v, %v, want match for true, <nil>`, eq, err)
+		}
+
+		for i := range df1.Series {
+			if df1.Series[i].Type() != df2.Series[i].Type() {
+				t.Fatalf(`df2.Series[%d].Type() = %v, want match for %v`, i, df2.Series[i].Type(), df1.Series[i].Type())
+			}
+		}
+
+		df3, err := snapshot.Load(ctx, bytes.NewReader(buf.Bytes()), snapshot.LoadOptions{
+			Columns: []string{"symbol", "qty"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if names := df3.Names(); len(names) != 2 || names[0] != "qty" || names[1] != "symbol" {
+			t.Fatalf(`df3.Names() = %v, want match for [qty symbol]`, names)
+		}
+
+		if v := dataframe.GetSeries[*int64](df3, "qty").Value(1); v != nil {
+			t.Fatalf(`qty.Value(1) = %v, want match for <nil>`, v)
+		}
+	}
+}
+
+func TestSnapshotCategorical(t *testing.T) {
+	ctx := context.Background()
+
+	size, err := dataframe.Cut(dataframe.NewSeries("size", nil, 50., 500., math.NaN()), []float64{0, 100, 1000}, dataframe.CutOptions{Labels: []string{"S", "L"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	df1 := dataframe.NewDataFrame(size)
+
+	var buf bytes.Buffer
+	if err := snapshot.Export(ctx, &buf, df1); err != nil {
+		t.Fatal(err)
+	}
+
+	df2, err := snapshot.Load(ctx, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, ok := df2.Series[0].(*dataframe.Categorical)
+	if !ok || !c.Ordered() || c.ValueAny(0) != "S" || c.ValueAny(1) != "L" || c.ValueAny(2) != nil {
+		t.Fatalf(`df2.Series[0] = %v, want match for ordered categorical [S L <nil>]`, df2.Series[0])
+	}
+
+	if cats := c.Categories(); len(cats) != 2 || cats[0] != "S" {
+		t.Fatalf(`c.Categories() = %v, want match for [S L]`, cats)
+	}
+}
+
+func TestSnapshotDecimal(t *testing.T) {
+	ctx := context.Background()
+
+	p := decimal.MustParse("-0.05")
+	df1 := dataframe.NewDataFrame(
+		decimal.NewSeries("pnl", nil, decimal.MustParse("0.10"), decimal.New(math.MinInt64, 18)),
+		dataframe.NewSeries("fee", nil, &p, nil),
+	)
+
+	var buf bytes.Buffer
+	if err := snapshot.Export(ctx, &buf, df1); err != nil {
+		t.Fatal(err)
+	}
+
+	df2, err := snapshot.Load(ctx, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pnl := dataframe.GetSeries[decimal.Decimal](df2, "pnl")
+	if pnl.ValueString(0) != "0.10" || pnl.Value(1) != decimal.New(math.MinInt64, 18) {
+		t.Fatalf(`pnl = %v, want match for [0.10 %v]`, pnl, decimal.New(math.MinInt64, 18))
+	}
+
+	if fee := dataframe.GetSeries[*decimal.Decimal](df2, "fee"); fee.ValueString(0) != "-0.05" || fee.Value(1) != nil {
+		t.Fatalf(`fee = %v, want match for [-0.05 <nil>]`, fee)
+	}
+
+	if !pnl.Sort(ctx) || pnl.ValueString(1) != "0.10" {
+		t.Fatalf(`pnl.Sort(ctx) = %v, want match for sorted by registered comparator`, pnl)
+	}
+}
+
+func TestSnapshotUnsupportedType(t *testing.T) {
+	ctx := context.Background()
+
+	df := dataframe.NewDataFrame(dataframe.NewSeries[any]("mixed", nil, 1, "one"))
+
+	var buf bytes.Buffer
+
+	if err := snapshot.Export(ctx, &buf, df); err == nil {
+		t.Fatalf(`snapshot.Export(ctx, &buf, df) = <nil>, want match for error`)
+	}
+
+	if buf.Len() != 0 {
+		t.Fatalf(`buf.Len() = %v, want match for 0`, buf.Len())
+	}
+}
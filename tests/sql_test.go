@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	gosql "database/sql"
+	"database/sql/driver"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/sql"
+)
+
+// stubDriver is a minimal database/sql driver. Queries return stubRows and
+// executed statements are recorded to stubExecs.
+type stubDriver struct{}
+
+type stubConn struct{}
+
+type stubExec struct {
+	query string
+	args  []driver.NamedValue
+}
+
+type stubColumn struct {
+	name     string
+	scanType reflect.Type
+	nullable bool
+}
+
+type stubRows struct {
+	columns []stubColumn
+	values  [][]driver.Value
+	row     int
+}
+
+var (
+	stubColumns []stubColumn
+	stubValues  [][]driver.Value
+	stubExecs   []stubExec
+)
+
+func init() {
+	gosql.Register("stub", stubDriver{})
+}
+
+func (stubDriver) Open(name string) (driver.Conn, error) { return stubConn{}, nil }
+
+func (stubConn) Prepare(query string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (stubConn) Close() error                              { return nil }
+func (stubConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+func (stubConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &stubRows{columns: stubColumns, values: stubValues}, nil
+}
+
+func (stubConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	stubExecs = append(stubExecs, stubExec{query, args})
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (r *stubRows) Columns() []string {
+	names := []string{}
+	for _, c := range r.columns {
+		names = append(names, c.name)
+	}
+	return names
+}
+
+func (r *stubRows) Close() error { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.row >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.row])
+	r.row++
+	return nil
+}
+
+func (r *stubRows) ColumnTypeScanType(index int) reflect.Type { return r.columns[index].scanType }
+
+func (r *stubRows) ColumnTypeNullable(index int) (bool, bool) { return r.columns[index].nullable, true }
+
+func TestSQLLoad(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	stubColumns = []stubColumn{
+		{"time", reflect.TypeOf(time.Time{}), false},
+		{"close", reflect.TypeOf(float64(0)), true},
+		{"volume", reflect.TypeOf(int64(0)), true},
+		{"symbol", reflect.TypeOf(""), false},
+	}
+	stubValues = [][]driver.Value{
+		{ts, 1.5, int64(10), []byte("EURUSD")},
+		{ts.Add(time.Minute), nil, nil, []byte("EURUSD")},
+	}
+
+	db, err := gosql.Open("stub", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, "SELECT * FROM bars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	df, err := sql.Load(ctx, rows)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if df.NRows() != 2 {
+		t.Fatalf(`df.NRows() = %v, want match for 2`, df.NRows())
+	}
+
+	if v := dataframe.GetSeries[time.Time](df, "time").Value(1); !v.Equal(ts.Add(time.Minute)) {
+		t.Fatalf(`time.Value(1) = %v, want match for %v`, v, ts.Add(time.Minute))
+	}
+
+	if v := dataframe.GetSeries[float64](df, "close").Value(1); !math.IsNaN(v) {
+		t.Fatalf(`close.Value(1) = %v, want match for NaN`, v)
+	}
+
+	volume := dataframe.GetSeries[*int64](df, "volume")
+	if *volume.Value(0) != 10 || volume.Value(1) != nil {
+		t.Fatalf(`volume = %v, want match for [10 <nil>]`, volume)
+	}
+
+	if v := dataframe.GetSeries[string](df, "symbol").Value(0); v != "EURUSD" {
+		t.Fatalf(`symbol.Value(0) = %v, want match for EURUSD`, v)
+	}
+}
+
+func TestSQLExport(t *testing.T) {
+	ctx := context.Background()
+
+	s1 := dataframe.NewSeries("symbol", nil, "EURUSD", "GBPUSD", "USDJPY")
+	s2 := dataframe.NewSeries("signal", nil, 1., math.NaN(), -1.)
+
+	df := dataframe.NewDataFrame(s1, s2)
+
+	db, err := gosql.Open("stub", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	stubExecs = nil
+
+	err = sql.Export(ctx, db, "signals", df, sql.ExportOptions{
+		BatchSize:   2,
+		Placeholder: sql.Dollar,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stubExecs) != 2 {
+		t.Fatalf(`len(stubExecs) = %v, want match for 2`, len(stubExecs))
+	}
+
+	want := "INSERT INTO signals (symbol, signal) VALUES ($1, $2), ($3, $4)"
+	if stubExecs[0].query != want {
+		t.Fatalf(`stubExecs[0].query = %v, want match for %v`, stubExecs[0].query, want)
+	}
+
+	if !strings.HasSuffix(stubExecs[1].query, "VALUES ($1, $2)") {
+		t.Fatalf(`stubExecs[1].query = %v, want match for suffix VALUES ($1, $2)`, stubExecs[1].query)
+	}
+
+	if stubExecs[0].args[3].Value != nil {
+		t.Fatalf(`stubExecs[0].args[3] = %v, want match for <nil>`, stubExecs[0].args[3].Value)
+	}
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/stream"
+)
+
+func TestTumblingWindows(t *testing.T) {
+	bars := dataframe.NewDataFrame(
+		dataframe.NewSeries[time.Time]("time", nil),
+		dataframe.NewSeries[float64]("open", nil),
+		dataframe.NewSeries[float64]("high", nil),
+		dataframe.NewSeries[float64]("close", nil),
+		dataframe.NewSeries[int]("ticks", nil),
+	)
+
+	w, err := stream.NewWindower(bars, stream.WindowOptions{
+		Size:            time.Minute,
+		AllowedLateness: 10 * time.Second,
+		Aggregations: map[string]stream.Aggregation{
+			"open":  {Field: "price", Fn: stream.First},
+			"high":  {Field: "price", Fn: stream.Max},
+			"close": {Field: "price", Fn: stream.Last},
+			"ticks": {Field: "price", Fn: stream.Count},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	ticks := make(chan map[string]any, 10)
+
+	for _, tick := range []struct {
+		sec   int
+		price float64
+	}{{5, 1}, {30, 3}, {65, 4}, {50, 2}, {75, 5}, {40, 9}, {130, 6}} {
+		ticks <- map[string]any{"time": t0.Add(time.Duration(tick.sec) * time.Second), "price": tick.price}
+	}
+	close(ticks)
+
+	if err := w.Run(context.Background(), ticks); err != nil {
+		t.Fatal(err)
+	}
+
+	// tick at 40s is late, the first bar is finalized by the tick at 75s
+	if bars.NRows() != 3 || w.Dropped() != 1 {
+		t.Fatalf(`bars = %v, want match for 3 bars and 1 dropped tick`, bars)
+	}
+
+	if row := bars.Row(0); row["time"] != t0 || row["open"] != 1. || row["high"] != 3. || row["close"] != 2. || row["ticks"] != 3 {
+		t.Fatalf(`bars.Row(0) = %v, want match for open 1, high 3, close 2, ticks 3`, row)
+	}
+}
+
+func TestSlidingWindows(t *testing.T) {
+	sums := dataframe.NewDataFrame(
+		dataframe.NewSeries[time.Time]("start", nil),
+		dataframe.NewSeries[float64]("volume", nil),
+	)
+
+	w, err := stream.NewWindower(sums, stream.WindowOptions{
+		StartKey:     "start",
+		Size:         2 * time.Minute,
+		Slide:        time.Minute,
+		Aggregations: map[string]stream.Aggregation{"volume": {Field: "volume", Fn: stream.Sum}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		if err := w.Add(map[string]any{"time": t0.Add(time.Duration(i) * time.Minute), "volume": 10}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// windows starting at 9:59, 10:00, 10:01 and 10:02
+	if sums.NRows() != 4 || sums.Row(1)["volume"] != 20. || sums.Row(3)["volume"] != 10. {
+		t.Fatalf(`sums = %v, want match for [10 20 20 10]`, sums)
+	}
+
+	if err := w.Add(map[string]any{"volume": 10}); err == nil {
+		t.Fatalf(`w.Add(row without time) returned <nil>, want match for error`)
+	}
+}
+
+func TestWindowerTarget(t *testing.T) {
+	opts := stream.WindowOptions{
+		Size: time.Minute,
+		Aggregations: map[string]stream.Aggregation{
+			"last":  {Field: "price", Fn: stream.Last},
+			"ticks": {Field: "price", Fn: stream.Count},
+		},
+	}
+
+	for _, target := range []*dataframe.DataFrame{
+		// missing series
+		dataframe.NewDataFrame(dataframe.NewSeries[time.Time]("time", nil), dataframe.NewSeries[int]("ticks", nil)),
+		// Count into float64 series
+		dataframe.NewDataFrame(dataframe.NewSeries[time.Time]("time", nil), dataframe.NewSeries[float64]("last", nil), dataframe.NewSeries[float64]("ticks", nil)),
+		// start is not time.Time
+		dataframe.NewDataFrame(dataframe.NewSeries[int64]("time", nil), dataframe.NewSeries[float64]("last", nil), dataframe.NewSeries[int]("ticks", nil)),
+	} {
+		if _, err := stream.NewWindower(target, opts); err == nil {
+			t.Fatalf(`stream.NewWindower(%v) returned <nil>, want match for error`, target.Names())
+		}
+	}
+
+	// Last returns float64 prices which don't fit into int series
+	bars := dataframe.NewDataFrame(dataframe.NewSeries[time.Time]("time", nil), dataframe.NewSeries[int]("last", nil), dataframe.NewSeries[int]("ticks", nil))
+
+	w, err := stream.NewWindower(bars, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	if err := w.Add(map[string]any{"time": t0, "price": 1.}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := w.Add(map[string]any{"time": t0.Add(time.Minute), "price": 2.}); err == nil || bars.NRows() != 0 {
+		t.Fatalf(`w.Add(...) = %v, bars = %v, want match for error and no bars`, err, bars)
+	}
+
+	// the windower is not locked by the error
+	if err := w.Add(map[string]any{"time": t0.Add(time.Minute), "price": 3.}); err != nil {
+		t.Fatal(err)
+	}
+}
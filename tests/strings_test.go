@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestStringAccessor(t *testing.T) {
+	s := dataframe.NewSeries("symbol", nil, " eurusd ", "gbp/usd", "btc")
+
+	str := dataframe.Str(s)
+
+	if up := dataframe.Str(str.TrimSpace()).Upper(); up.Values[0] != "EURUSD" {
+		t.Fatalf(`Str(s).TrimSpace().Upper() = %v, want match for [EURUSD GBP/USD BTC]`, up.Values)
+	}
+
+	if c := str.Contains("usd"); !c.Values[0] || !c.Values[1] || c.Values[2] {
+		t.Fatalf(`Str(s).Contains("usd") = %v, want match for [true true false]`, c.Values)
+	}
+
+	if l := str.Len(); l.Values[0] != 8 || l.Values[2] != 3 {
+		t.Fatalf(`Str(s).Len() = %v, want match for [8 7 3]`, l.Values)
+	}
+
+	parts := str.Split("/", 2)
+	if len(parts) != 2 || parts[0].Name() != "symbol_0" || parts[0].Values[1] != "gbp" || parts[1].Values[1] != "usd" || parts[1].Values[2] != "" {
+		t.Fatalf(`Str(s).Split("/", 2) = %v, want match for [[ eurusd  gbp btc] [ usd ]]`, parts)
+	}
+
+	if e := str.Extract(regexp.MustCompile(`(\w+)/`), 1); e.Values[0] != "" || e.Values[1] != "gbp" {
+		t.Fatalf(`Str(s).Extract(...) = %v, want match for [ gbp ]`, e.Values)
+	}
+
+	if p := str.Pad(5, dataframe.PAD_LEFT, '*'); p.Values[2] != "**btc" {
+		t.Fatalf(`Str(s).Pad(5, PAD_LEFT, '*') = %v, want match for [ eurusd  gbp/usd **btc]`, p.Values)
+	}
+
+	if sub := str.Substring(-3, 100); sub.Values[1] != "usd" {
+		t.Fatalf(`Str(s).Substring(-3, 100) = %v, want match for [sd  usd btc]`, sub.Values)
+	}
+}
+
+func TestStringPtrAccessor(t *testing.T) {
+	a, b := "AAPL:NASDAQ", "MSFT"
+	s := dataframe.NewSeries("ticker", nil, &a, nil, &b)
+
+	str := dataframe.StrPtr(s)
+
+	if l := str.Len(); *l.Values[0] != 11 || l.Values[1] != nil {
+		t.Fatalf(`StrPtr(s).Len() = %v, want match for [11 <nil> 4]`, l.Values)
+	}
+
+	e := str.Extract(regexp.MustCompile(`:(\w+)$`), 1)
+	if *e.Values[0] != "NASDAQ" || e.Values[1] != nil || e.Values[2] != nil {
+		t.Fatalf(`StrPtr(s).Extract(...) = %v, want match for [NASDAQ <nil> <nil>]`, e.Values)
+	}
+
+	parts := str.Split(":", 2)
+	if *parts[0].Values[2] != "MSFT" || parts[1].Values[2] != nil || parts[1].Values[1] != nil {
+		t.Fatalf(`StrPtr(s).Split(":", 2) = %v, want match for [[AAPL <nil> MSFT] [NASDAQ <nil> <nil>]]`, parts)
+	}
+}
@@ -0,0 +1,119 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/csv"
+)
+
+type structsOHLC struct {
+	Open  float64 `dataframe:"o"`
+	Close float64 `dataframe:"c"`
+}
+
+type structsBar struct {
+	Time        time.Time `dataframe:"time"`
+	structsOHLC `dataframe:"bid"`
+	Volume      *int64 `dataframe:"v"`
+	Symbol      string
+	Ignored     string `dataframe:"-"`
+	private     int
+}
+
+func TestStructsRoundTrip(t *testing.T) {
+	ts := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	volume := int64(100)
+
+	bars := []structsBar{
+		{Time: ts, structsOHLC: structsOHLC{1, 2}, Volume: &volume, Symbol: "EURUSD", Ignored: "x"},
+		{Time: ts.Add(time.Minute), structsOHLC: structsOHLC{2, 3}, Symbol: "EURUSD", private: 1},
+	}
+
+	df, err := dataframe.FromStructs("", bars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := strings.Join(df.Names(), ",")
+	if names != "time,bid_o,bid_c,v,Symbol" {
+		t.Fatalf(`df.Names() = %v, want match for time,bid_o,bid_c,v,Symbol`, names)
+	}
+
+	if v := dataframe.GetSeries[*int64](df, "v").Value(1); v != nil {
+		t.Fatalf(`v.Value(1) = %v, want match for <nil>`, v)
+	}
+
+	out, err := dataframe.ToStructs[structsBar](df)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != 2 || out[1].Close != 3 || *out[0].Volume != 100 || out[1].Volume != nil || out[0].Ignored != "" {
+		t.Fatalf(`ToStructs[structsBar](df) = %+v, want match for %+v without ignored fields`, out, bars)
+	}
+
+	type closeOnly struct {
+		Close *float64 `dataframe:"bid_c"`
+		Count int      `dataframe:"v"`
+	}
+
+	out2, err := dataframe.ToStructs[closeOnly](df)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *out2[0].Close != 2 || out2[0].Count != 100 || out2[1].Count != 0 {
+		t.Fatalf(`ToStructs[closeOnly](df) = %+v, want match for [{2 100} {3 0}]`, out2)
+	}
+}
+
+func TestStructsNullEmbedded(t *testing.T) {
+	type bar struct {
+		*structsOHLC `dataframe:"ask"`
+		Symbol       string
+	}
+
+	df, err := dataframe.FromStructs("eurusd", []bar{{&structsOHLC{1, 2}, "EURUSD"}, {nil, "EURUSD"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := strings.Join(df.Names(), ",")
+	if names != "eurusd_ask_o,eurusd_ask_c,eurusd_Symbol" {
+		t.Fatalf(`df.Names() = %v, want match for eurusd_ask_o,eurusd_ask_c,eurusd_Symbol`, names)
+	}
+
+	// fields of nil embedded struct are nulls
+	if v := dataframe.GetSeries[float64](df, "eurusd_ask_c").Value(1); v == v {
+		t.Fatalf(`eurusd_ask_c.Value(1) = %v, want match for NaN`, v)
+	}
+}
+
+func TestCSVLoadInto(t *testing.T) {
+	ctx := context.Background()
+
+	content := "time,bid_o,bid_c,v,other\n" +
+		"2022-06-01T00:00:00Z,1.5,1.6,10,x\n" +
+		"2022-06-01T00:01:00Z,1.6,1.7,,y\n"
+
+	bars, err := csv.LoadInto[structsBar](ctx, strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bars) != 2 {
+		t.Fatalf(`len(bars) = %v, want match for 2`, len(bars))
+	}
+
+	if bars[1].Close != 1.7 || *bars[0].Volume != 10 || bars[1].Volume != nil || bars[0].Symbol != "" {
+		t.Fatalf(`bars = %+v, unexpected values`, bars)
+	}
+
+	if !bars[1].Time.Equal(time.Date(2022, 6, 1, 0, 1, 0, 0, time.UTC)) {
+		t.Fatalf(`bars[1].Time = %v, want match for 2022-06-01T00:01:00Z`, bars[1].Time)
+	}
+}
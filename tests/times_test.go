@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestTimeAccessor(t *testing.T) {
+	s := dataframe.NewSeries("time", nil,
+		time.Date(2022, 6, 17, 13, 45, 30, 0, time.UTC),
+		time.Time{},
+	)
+
+	dt := dataframe.Dt(s)
+
+	if y, m, d, h := dt.Year(), dt.Month(), dt.Day(), dt.Hour(); y.Values[0] != 2022 || m.Values[0] != 6 || d.Values[0] != 17 || h.Values[0] != 13 || y.Values[1] != 0 {
+		t.Fatalf(`Dt(s) components = %v %v %v %v, want match for 2022 6 17 13`, y.Values, m.Values, d.Values, h.Values)
+	}
+
+	if w := dt.Weekday(); w.Values[0] != int(time.Friday) {
+		t.Fatalf(`Dt(s).Weekday() = %v, want match for [5 0]`, w.Values)
+	}
+
+	if tr := dt.Truncate(time.Hour); !tr.Values[0].Equal(time.Date(2022, 6, 17, 13, 0, 0, 0, time.UTC)) || !tr.Values[1].IsZero() {
+		t.Fatalf(`Dt(s).Truncate(time.Hour) = %v, want match for [2022-06-17 13:00:00 <zero>]`, tr.Values)
+	}
+
+	if r := dt.Round(time.Hour); r.Values[0].Hour() != 14 {
+		t.Fatalf(`Dt(s).Round(time.Hour) = %v, want match for [2022-06-17 14:00:00 <zero>]`, r.Values)
+	}
+
+	if in := dt.In(time.FixedZone("CEST", 2*3600)); in.Values[0].Hour() != 15 {
+		t.Fatalf(`Dt(s).In(CEST) = %v, want match for [2022-06-17 15:45:30 +0200 <zero>]`, in.Values)
+	}
+
+	if u := dt.UnixMilli(); u.Values[0] != 1655473530000 {
+		t.Fatalf(`Dt(s).UnixMilli() = %v, want match for [1655473530000 0]`, u.Values)
+	}
+
+	if f := dt.Format("2006-01-02"); f.Values[0] != "2022-06-17" || f.Values[1] != "" {
+		t.Fatalf(`Dt(s).Format("2006-01-02") = %v, want match for [2022-06-17 ]`, f.Values)
+	}
+}
+
+func TestDateRange(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s := dataframe.DateRange("time", start, start.Add(time.Hour), 15*time.Minute)
+
+	if s.NRows() != 5 || !s.Values[4].Equal(start.Add(time.Hour)) || !s.IsLessThanFunc(s.Values[0], s.Values[1]) {
+		t.Fatalf(`DateRange("time", start, start + 1h, 15m) = %v, want match for 5 times`, s.Values)
+	}
+
+	if s := dataframe.DateRange("time", start, start.Add(-time.Hour), time.Minute); s.NRows() != 0 {
+		t.Fatalf(`DateRange("time", start, start - 1h, 1m) = %v, want match for []`, s.Values)
+	}
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestTxnCommit(t *testing.T) {
+	close := dataframe.NewSeries("close", nil, 1., 2., 3.)
+	df := dataframe.NewDataFrame(close, dataframe.NewCategorical("side", nil, "buy", "sell", "buy"))
+
+	var kinds []dataframe.EventKind
+	sub := close.Subscribe(func(ev dataframe.Event[float64]) {
+		kinds = append(kinds, ev.Kind)
+	})
+	defer sub.Unsubscribe()
+
+	err := df.Txn(func(tx *dataframe.Tx) error {
+		tx.Remove(0)
+		tx.Append([]any{4., "sell"})
+		tx.Update(0, "side", "hold")
+
+		if close.NRows() != 3 || close.Value(0) != 1. {
+			t.Fatalf(`close = %v, want match for unchanged values in the transaction`, close.Values)
+		}
+		return nil
+	})
+
+	if err != nil || df.NRows() != 3 || close.Value(0) != 2. || close.Value(-1) != 4. || df.Row(0)["side"] != "hold" {
+		t.Fatalf(`df = %v, err = %v, want match for committed [2 3 4]`, df, err)
+	}
+
+	if len(kinds) != 1 || kinds[0] != dataframe.EVENT_REPLACE {
+		t.Fatalf(`events = %v, want match for [replace]`, kinds)
+	}
+}
+
+func TestTxnRollback(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3.),
+		dataframe.NewCategorical("side", nil, "buy", "sell", "buy"),
+	)
+
+	errStale := errors.New("stale")
+
+	err := df.Txn(func(tx *dataframe.Tx) error {
+		tx.Remove(0)
+		tx.Update(0, "side", "hold")
+		return errStale
+	})
+
+	if err != errStale || df.NRows() != 3 || df.Row(0)["close"] != 1. || df.Row(1)["side"] != "sell" {
+		t.Fatalf(`df = %v, err = %v, want match for unchanged [1 2 3]`, df, err)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf(`df.Txn(...) did not panic, want match for a panic`)
+			}
+		}()
+
+		df.Txn(func(tx *dataframe.Tx) error {
+			tx.Append([]any{4., "sell"})
+			tx.Append([]any{5.}) // length mismatch panics
+			return nil
+		})
+	}()
+
+	if df.NRows() != 3 || df.Series[0].NRows() != 3 || df.Row(2)["close"] != 3. {
+		t.Fatalf(`df = %v, want match for unchanged [1 2 3] after a panic`, df)
+	}
+}
+
+func TestTxnReentry(t *testing.T) {
+	df := dataframe.NewDataFrame(dataframe.NewSeries("close", nil, 1., 2., 3.))
+
+	err := df.Txn(func(tx *dataframe.Tx) error {
+		tx.Remove(0)
+		df.Remove(0) // locks df held by the transaction
+		return nil
+	})
+
+	if err != dataframe.ErrTxnReentry || df.NRows() != 3 {
+		t.Fatalf(`df.Txn(...) = %v, df = %v, want match for ErrTxnReentry and unchanged df`, err, df)
+	}
+
+	err = df.Txn(func(tx *dataframe.Tx) error {
+		return df.Txn(func(tx *dataframe.Tx) error { return nil })
+	})
+
+	if err != dataframe.ErrTxnReentry {
+		t.Fatalf(`nested df.Txn(...) = %v, want match for ErrTxnReentry`, err)
+	}
+
+	// other goroutines wait for the transaction
+	rows := make(chan int, 1)
+
+	err = df.Txn(func(tx *dataframe.Tx) error {
+		go func() { rows <- df.NRows() }()
+		time.Sleep(10 * time.Millisecond)
+
+		tx.Remove(0)
+		return nil
+	})
+
+	if n := <-rows; err != nil || n != 2 {
+		t.Fatalf(`df.NRows() = %v, err = %v, want match for 2 after commit`, n, err)
+	}
+}
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesUnique(t *testing.T) {
+	s := dataframe.NewSeries[any]("x", nil, "a", 1, "a", nil, 1, nil, "a")
+
+	if u := s.Unique(); len(u.Values) != 3 || u.Values[0] != "a" || u.Values[1] != 1 || u.Values[2] != nil {
+		t.Fatalf(`s.Unique() = %v, want match for [a 1 <nil>]`, u.Values)
+	}
+
+	if n := s.NUnique(); n != 3 {
+		t.Fatalf(`s.NUnique() = %v, want match for 3`, n)
+	}
+
+	vc := s.ValueCounts()
+	if vc.NRows() != 3 || vc.Row(0)["x"] != "a" || vc.Row(0)["count"] != 3 || vc.Row(1)["x"] != 1 || vc.Row(2)["count"] != 2 {
+		t.Fatalf(`s.ValueCounts() = %v, want match for x: [a 1 <nil>], count: [3 2 2]`, vc)
+	}
+
+	// counts of the series named "count" do not collide with its values
+	vc = dataframe.NewSeries("count", nil, 1, 2, 1).ValueCounts()
+	if vc.Row(0)["count"] != 1 || vc.Row(0)["count_count"] != 2 {
+		t.Fatalf(`count.ValueCounts() = %v, want match for count: [1 2], count_count: [2 1]`, vc)
+	}
+}
+
+func TestDataFrameDuplicates(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("time", nil, 1, 1, 2, 1),
+		dataframe.NewSeries("symbol", nil, "A", "B", "A", "A"),
+		dataframe.NewSeries("close", nil, 1., 2., 3., 4.),
+	)
+
+	for keep, want := range map[dataframe.KeepMethod][]bool{
+		dataframe.KEEP_FIRST: {false, false, false, true},
+		dataframe.KEEP_LAST:  {true, false, false, false},
+		dataframe.KEEP_NONE:  {true, false, false, true},
+	} {
+		dup, err := df.Duplicated([]string{"time", "symbol"}, keep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range want {
+			if dup.Values[i] != want[i] {
+				t.Fatalf(`df.Duplicated([time symbol], %v) = %v, want match for %v`, keep, dup.Values, want)
+			}
+		}
+	}
+
+	ndf, err := df.DropDuplicates(context.Background(), []string{"time", "symbol"}, dataframe.KEEP_LAST)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ndf.NRows() != 3 || ndf.Row(2)["close"] != 4. || df.NRows() != 4 {
+		t.Fatalf(`df.DropDuplicates(ctx, [time symbol], KEEP_LAST) = %v, want match for close: [2 3 4]`, ndf)
+	}
+
+	if _, err := df.DropDuplicates(context.Background(), nil, dataframe.KeepMethod(10)); err == nil {
+		t.Fatalf(`df.DropDuplicates(ctx, nil, 10) returned <nil>, want match for error`)
+	}
+}
@@ -0,0 +1,77 @@
+package tests
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+func TestSeriesView(t *testing.T) {
+	s := dataframe.NewSeries("x", &dataframe.SeriesInit{Capacity: 10}, 1, 2, 3, 4, 5)
+
+	tail := s.Tail(2)
+	if !reflect.DeepEqual(tail.Values, []int{4, 5}) || tail.Value(-1) != 5 || tail.Name() != "x" {
+		t.Fatalf(`s.Tail(2) = %v, want match for [4 5]`, tail.Values)
+	}
+
+	// views share values of the series
+	s.Update(4, 50)
+	if tail.Value(1) != 50 {
+		t.Fatalf(`s.Tail(2).Value(1) = %v, want match for 50`, tail.Value(1))
+	}
+
+	view := s.View(dataframe.Range(1, 2))
+	c := view.Copy()
+	c.Update(0, 20)
+
+	if !reflect.DeepEqual(view.Values, []int{2, 3}) || s.Value(1) != 2 || c.Value(0) != 20 {
+		t.Fatalf(`s.View(1, 2).Copy() = %v, want match for a copy of [2 3]`, c.Values)
+	}
+
+	var r dataframe.SeriesReader = s.Head(10)
+	if r.NRows() != 5 || r.ValueAny(0) != 1 {
+		t.Fatalf(`s.Head(10) = %v, want match for all 5 rows`, r)
+	}
+
+	// appending to the series does not change views
+	s.Append([]int{6})
+	if tail.NRows() != 2 || s.Head(0).NRows() != 0 {
+		t.Fatalf(`s.Tail(2) = %v, want match for 2 rows`, tail.Values)
+	}
+}
+
+func TestDataFrameView(t *testing.T) {
+	df := dataframe.NewDataFrame(
+		dataframe.NewSeries("close", nil, 1., 2., 3., 4.),
+		dataframe.NewCategorical("side", nil, "buy", "sell", "buy", "sell"),
+	)
+
+	view := df.Tail(3)
+	if view.NRows() != 3 || view.Row(0)["close"] != 2. || view.Row(2)["side"] != "sell" {
+		t.Fatalf(`df.Tail(3) = %v, want match for the last 3 rows`, view)
+	}
+
+	c := view.Copy()
+	c.Remove(0)
+
+	if c.NRows() != 2 || df.NRows() != 4 || view.NRows() != 3 {
+		t.Fatalf(`df.Tail(3).Copy().Remove(0) = %v, want match for 2 rows`, c)
+	}
+
+	// categories added to the DataFrame are visible in the view
+	df.Update(1, "side", "hold")
+	if view.Row(0)["side"] != "hold" {
+		t.Fatalf(`df.Tail(3).Row(0) = %v, want match for side: hold`, view.Row(0))
+	}
+
+	// the view keeps its values if categories are replaced
+	df.Series[1].(*dataframe.Categorical).SetCategories([]string{"sell"}, false)
+	if view.Row(0)["side"] != "hold" || view.Row(1)["side"] != "buy" || df.Row(2)["side"] != nil {
+		t.Fatalf(`df.Tail(3) = %v, want match for side: [hold buy sell]`, view)
+	}
+
+	if names := df.View(dataframe.Range(1, 1)).Names(); !reflect.DeepEqual(names, []string{"close", "side"}) {
+		t.Fatalf(`df.View(1, 1).Names() = %v, want match for [close side]`, names)
+	}
+}
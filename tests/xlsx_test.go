@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/xlsx"
+)
+
+func TestXLSXExportLoad(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2022, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	s1 := dataframe.NewSeries("time", nil, ts, ts.Add(time.Hour), ts.Add(2*time.Hour))
+	s2 := dataframe.NewSeries("price", nil, 1.5, math.NaN(), -2.25)
+	s3 := dataframe.NewSeries("qty", nil, 1, 20, 300)
+	s4 := dataframe.NewSeries("symbol", nil, "EURUSD", "A & <B>", "")
+	s5 := dataframe.NewSeries("buy", nil, true, false, true)
+
+	df1 := dataframe.NewDataFrame(s1, s2, s3, s4, s5)
+	df2 := dataframe.NewDataFrame(dataframe.NewSeries("x", nil, 1., 2.))
+
+	var buf bytes.Buffer
+
+	err := xlsx.Export(ctx, &buf,
+		xlsx.Sheet{Name: "bars", DataFrame: df1},
+		xlsx.Sheet{Name: "other", DataFrame: df2},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := bytes.NewReader(buf.Bytes())
+
+	df3, err := xlsx.Load(ctx, r, r.Size(), map[string]xlsx.ConverterAny{
+		"time":   xlsx.Time,
+		"price":  xlsx.Float64,
+		"qty":    xlsx.Int,
+		"symbol": xlsx.String,
+		"buy":    xlsx.Bool,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	df3.ReorderColumns([]string{"time", "price", "qty", "symbol", "buy"})
+
+	if eq, err := df1.IsEqual(ctx, df3); !eq || err != nil {
+		t.Fatalf(`eq, err := df1.IsEqual(ctx, df3) = %v, %v, want match for true, <nil>`, eq, err)
+	}
+
+	df4, err := xlsx.Load(ctx, r, r.Size(), map[string]xlsx.ConverterAny{
+		"x": xlsx.Float64,
+	}, xlsx.LoadOptions{Sheet: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if eq, err := df2.IsEqual(ctx, df4); !eq || err != nil {
+		t.Fatalf(`eq, err := df2.IsEqual(ctx, df4) = %v, %v, want match for true, <nil>`, eq, err)
+	}
+
+	if _, err := xlsx.Load(ctx, r, r.Size(), nil, xlsx.LoadOptions{Sheet: "missing"}); err == nil {
+		t.Fatalf(`xlsx.Load(...) with missing sheet returned <nil>, want match for error`)
+	}
+}
@@ -0,0 +1,179 @@
+package dataframe
+
+import (
+	"time"
+)
+
+// TimeAccessor provides datetime operations on Series[time.Time]. All the
+// operations return new series of the same name. Zero times are treated as
+// null: they are kept as zero times, their components are 0 and they are
+// formatted as empty strings.
+type TimeAccessor struct {
+	s    *Series[time.Time]
+	opts Options
+}
+
+// Dt returns datetime accessor of the series.
+//
+// Example:
+//
+//	hour := Dt(times).Hour()
+//
+func Dt(s *Series[time.Time], options ...Options) *TimeAccessor {
+	return &TimeAccessor{s: s, opts: DefaultOptions(options...)}
+}
+
+// DateRange creates a series of times from start to end (inclusive) with step.
+// The series has comparators of the registered time.Time type.
+//
+// Example:
+//
+//	minutes := DateRange("time", start, end, time.Minute)
+//
+func DateRange(name string, start, end time.Time, step time.Duration) *Series[time.Time] {
+	if step <= 0 {
+		panic("step must be positive")
+	}
+
+	var n int
+	if !end.Before(start) {
+		n = int(end.Sub(start)/step) + 1
+	}
+
+	s, err := NewSeriesFromType(TypeName[time.Time](), name, &SeriesInit{Capacity: n})
+	if err != nil {
+		panic(err)
+	}
+
+	ts := s.(*Series[time.Time])
+	for t := start; !t.After(end); t = t.Add(step) {
+		ts.Values = append(ts.Values, t)
+	}
+
+	return ts
+}
+
+// Year returns years of times.
+func (a *TimeAccessor) Year() *Series[int] {
+	return timeMap(a, time.Time.Year)
+}
+
+// Month returns months of times (1 - 12).
+func (a *TimeAccessor) Month() *Series[int] {
+	return timeMap(a, func(t time.Time) int { return int(t.Month()) })
+}
+
+// Day returns days of month of times.
+func (a *TimeAccessor) Day() *Series[int] {
+	return timeMap(a, time.Time.Day)
+}
+
+// Hour returns hours of times.
+func (a *TimeAccessor) Hour() *Series[int] {
+	return timeMap(a, time.Time.Hour)
+}
+
+// Minute returns minutes of times.
+func (a *TimeAccessor) Minute() *Series[int] {
+	return timeMap(a, time.Time.Minute)
+}
+
+// Second returns seconds of times.
+func (a *TimeAccessor) Second() *Series[int] {
+	return timeMap(a, time.Time.Second)
+}
+
+// Weekday returns days of week of times (Sunday = 0).
+func (a *TimeAccessor) Weekday() *Series[int] {
+	return timeMap(a, func(t time.Time) int { return int(t.Weekday()) })
+}
+
+// YearDay returns days of year of times (1 - 366).
+func (a *TimeAccessor) YearDay() *Series[int] {
+	return timeMap(a, time.Time.YearDay)
+}
+
+// Truncate rounds times down to a multiple of d since the zero time.
+func (a *TimeAccessor) Truncate(d time.Duration) *Series[time.Time] {
+	return timeMapTime(a, func(t time.Time) time.Time { return t.Truncate(d) })
+}
+
+// Round rounds times to the nearest multiple of d since the zero time.
+func (a *TimeAccessor) Round(d time.Duration) *Series[time.Time] {
+	return timeMapTime(a, func(t time.Time) time.Time { return t.Round(d) })
+}
+
+// In converts times to location loc.
+func (a *TimeAccessor) In(loc *time.Location) *Series[time.Time] {
+	return timeMapTime(a, func(t time.Time) time.Time { return t.In(loc) })
+}
+
+// InZone converts times to the location of the IANA zone name (see time.LoadLocation).
+//
+// Example:
+//
+//	ny, err := Dt(times).InZone("America/New_York")
+//
+func (a *TimeAccessor) InZone(name string) (*Series[time.Time], error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	return a.In(loc), nil
+}
+
+// Unix returns times as Unix seconds.
+func (a *TimeAccessor) Unix() *Series[int64] {
+	return timeMap(a, time.Time.Unix)
+}
+
+// UnixMilli returns times as Unix milliseconds.
+func (a *TimeAccessor) UnixMilli() *Series[int64] {
+	return timeMap(a, time.Time.UnixMilli)
+}
+
+// Format formats times by layout (see time.Time.Format).
+func (a *TimeAccessor) Format(layout string) *Series[string] {
+	return timeMap(a, func(t time.Time) string { return t.Format(layout) })
+}
+
+// timeMap maps values of the accessor's series by fn, zero times are mapped
+// to zero values
+func timeMap[R any](a *TimeAccessor, fn func(time.Time) R) *Series[R] {
+	s := a.s
+
+	if !a.opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := NewSeries[R](s.name, &SeriesInit{Capacity: len(s.Values)})
+	for _, t := range s.Values {
+		var r R
+		if !t.IsZero() {
+			r = fn(t)
+		}
+		ns.Values = append(ns.Values, r)
+	}
+
+	return ns
+}
+
+// timeMapTime maps values of the accessor's series by fn, comparators and
+// formatter of the series are kept
+func timeMapTime(a *TimeAccessor, fn func(time.Time) time.Time) *Series[time.Time] {
+	s := a.s
+
+	if !a.opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	ns := s.emptyCopy(len(s.Values)).(*Series[time.Time])
+	for _, t := range s.Values {
+		if !t.IsZero() {
+			t = fn(t)
+		}
+		ns.Values = append(ns.Values, t)
+	}
+
+	return ns
+}
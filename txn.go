@@ -0,0 +1,168 @@
+package dataframe
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrTxnReentry is returned by Txn when fn calls a method of the DataFrame
+// which locks it instead of the method of tx.
+var ErrTxnReentry = errors.New("dataframe is locked by the running transaction")
+
+// Tx is a transaction of a DataFrame started by Txn. It embeds a shadow copy
+// of the DataFrame, so all methods of the DataFrame can be used to change it.
+// Changes are not visible outside the transaction until it is committed.
+type Tx struct {
+	*DataFrame
+}
+
+// Txn runs fn in a transaction. The DataFrame is write-locked while fn runs
+// and fn changes a shadow copy of the DataFrame. Series are copied on write,
+// so starting a transaction takes O(1) per series. If fn returns nil, changes
+// are committed atomically. If fn returns an error or panics, changes are
+// rolled back, the error is returned and the panic is propagated.
+//
+// Committed values are taken by the original series, so references to them
+// stay valid. Subscribers of changed series and of the DataFrame receive
+// EVENT_REPLACE once the transaction is committed.
+//
+// fn must use methods of tx, not of the DataFrame. The DataFrame is locked
+// until fn returns, so its methods called by fn would wait forever. Such
+// a call panics with ErrTxnReentry instead, the transaction is rolled back
+// and Txn returns ErrTxnReentry. Methods of the original series don't lock
+// the DataFrame and see values before the transaction.
+//
+// Example:
+//
+//	err := df.Txn(func(tx *Tx) error {
+//		tx.Remove(0)
+//		tx.Append([]any{ 4., "sell" })
+//		tx.Update(tx.NRows() - 1, "total", 10.)
+//		return nil
+//	})
+//
+func (df *DataFrame) Txn(fn func(tx *Tx) error, options ...Options) error {
+	opts := DefaultOptions(options...)
+
+	defer df.events.flush()
+
+	if !opts.DontLock {
+		df.lock.Lock(); defer df.lock.Unlock()
+	}
+
+	shadow := df.Copy()
+
+	// original series of the shadow series
+	origins := make(map[SeriesAny]SeriesAny, len(df.Series))
+	for i, s := range shadow.Series {
+		origins[s] = df.Series[i]
+	}
+
+	// a panic leaves the DataFrame unchanged, the shadow is discarded
+	if err := df.lock.run(func() error { return fn(&Tx{DataFrame: shadow}) }); err != nil {
+		return err
+	}
+
+	df.commit(shadow, origins)
+	return nil
+}
+
+// txnLock is the lock of the DataFrame. It panics with ErrTxnReentry instead
+// of waiting forever if the DataFrame is locked by fn of the transaction
+// which holds the lock.
+type txnLock struct {
+	sync.RWMutex
+
+	// id of the goroutine running fn of a transaction, 0 if there is none
+	owner int64
+}
+
+func (l *txnLock) Lock() {
+	l.checkReentry()
+	l.RWMutex.Lock()
+}
+
+func (l *txnLock) RLock() {
+	l.checkReentry()
+	l.RWMutex.RLock()
+}
+
+func (l *txnLock) checkReentry() {
+	if owner := atomic.LoadInt64(&l.owner); owner != 0 && owner == goroutineID() {
+		panic(ErrTxnReentry)
+	}
+}
+
+// run calls fn of the transaction, the lock must be held. ErrTxnReentry
+// panic of fn is returned as error, other panics are propagated.
+func (l *txnLock) run(fn func() error) (err error) {
+	prev := atomic.SwapInt64(&l.owner, goroutineID())
+	defer atomic.StoreInt64(&l.owner, prev)
+
+	defer func() {
+		if x := recover(); x != nil {
+			if x != ErrTxnReentry {
+				panic(x)
+			}
+			err = ErrTxnReentry
+		}
+	}()
+
+	return fn()
+}
+
+// goroutineID returns id of the current goroutine parsed from its stack trace.
+// It is called only while a transaction is running.
+func goroutineID() int64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+
+	// "goroutine 18 [running]: ..."
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+
+	id, _ := strconv.ParseInt(string(b), 10, 64)
+	return id
+}
+
+// commit takes the state of the shadow. Original series are locked together,
+// so readers of the series can not observe partially committed values.
+func (df *DataFrame) commit(shadow *DataFrame, origins map[SeriesAny]SeriesAny) {
+	series := make([]SeriesAny, 0, len(shadow.Series))
+	committed := make([]SeriesAny, 0, len(shadow.Series))
+
+	for _, s := range shadow.Series {
+		if orig, ok := origins[s]; ok {
+			orig.Lock()
+			committed = append(committed, orig)
+			s = orig
+		}
+		series = append(series, s)
+	}
+
+	for _, s := range shadow.Series {
+		if orig, ok := origins[s]; ok {
+			orig.assign(s)
+		}
+	}
+
+	for _, s := range committed {
+		s.Unlock()
+	}
+
+	for _, s := range committed {
+		s.flushEvents()
+	}
+
+	df.Series, df.n, df.maxLen, df.index = series, shadow.n, shadow.maxLen, shadow.index
+
+	if df.events.active() {
+		df.events.enqueue(Event[map[string]any]{Kind: EVENT_REPLACE, Count: df.n})
+	}
+}
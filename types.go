@@ -82,6 +82,18 @@ type SeriesAny interface {
 	// to Copy.
 	CopyAny(options ...RangeOptions) SeriesAny
 
+	// ShiftAny returns a new series with values shifted by n rows.
+	// Vacated rows are filled with null.
+	ShiftAny(n int, options ...Options) SeriesAny
+
+	// SetMaxLen caps the series to n rows. Appending to the full series
+	// evicts the oldest rows. If n is 0, the series is not capped.
+	SetMaxLen(n int, options ...Options)
+
+	// MaxLen returns the maximal number of rows of the capped series or 0
+	// if the series is not capped.
+	MaxLen(options ...Options) int
+
 	// Table will produce the Series in a table.
 	Table(options ...TableOptions) string
 
@@ -108,4 +120,78 @@ type SeriesAny interface {
 
 	// Creates clone with empty Values
 	cloneAsEmpty(size ...int) SeriesAny
+
+	// Creates copy without values, comparators and formatter are kept
+	emptyCopy(capacity int) SeriesAny
+
+	// Appends all the values of series of the same type
+	appendSeries(src SeriesAny)
+
+	// Appends n null values
+	appendNulls(n int)
+
+	// Returns true if IsLessThanFunc is set
+	hasIsLessThanFunc() bool
+
+	// Creates series of values in rows [start, end) shared with the series
+	window(start, end int) SeriesAny
+
+	// Takes values and properties of series of the same type, it does not lock
+	assign(src SeriesAny)
+
+	// Delivers queued events of changes
+	flushEvents()
+
+	// Swaps values of 2 rows without queuing events, it does not lock
+	swap(row1, row2 int)
+
+	// Queues EVENT_SORT of all the rows, it does not lock
+	sorted()
+}
+
+// SeriesReader contains read-only methods of SeriesAny. It is implemented
+// by SeriesAny and by views of series.
+type SeriesReader interface {
+	// Name returns the series name.
+	Name(options ...Options) string
+
+	// Type returns the type of values of the series.
+	Type() string
+
+	// NRows returns how many rows the series contains.
+	NRows(options ...Options) int
+
+	// ValueAny returns the value of a particular row.
+	ValueAny(row int, options ...Options) any
+
+	// ValueString returns a string representation of a
+	// particular row.
+	ValueString(row int, options ...Options) string
+
+	// IteratorAny will return a iterator that can be used to iterate through all the values.
+	IteratorAny(options ...IteratorOptions) Iterator[any]
+
+	// IsEqualAnyFunc	returns true if a is equal to b.
+	IsEqualAnyFunc(a, b any) bool
+
+	// IsLessThanAnyFunc	returns true if a is less than b.
+	IsLessThanAnyFunc(a, b any) bool
+
+	// CopyAny will create a new copy of the series.
+	CopyAny(options ...RangeOptions) SeriesAny
+
+	// Table will produce the Series in a table.
+	Table(options ...TableOptions) string
+
+	// String implements the fmt.Stringer interface.
+	String() string
+
+	// IsEqualAny returns true if s2's values are equal to s.
+	IsEqualAny(ctx context.Context, s2 SeriesAny, options ...IsEqualOptions) (bool, error)
+
+	// RWMutex RLock
+	RLock()
+
+	// RWMutex RUnlock
+	RUnlock()
 }
\ No newline at end of file
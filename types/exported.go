@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/decimal"
 	"time"
 )
 
@@ -28,4 +29,6 @@ type SeriesString 		= dataframe.Series[string]
 
 type SeriesTime 		= dataframe.Series[time.Time]
 
+type SeriesDecimal 		= dataframe.Series[decimal.Decimal]
+
 type SeriesMixed 		= dataframe.Series[any]
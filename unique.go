@@ -0,0 +1,231 @@
+package dataframe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KeepMethod defines which of duplicate rows are kept.
+type KeepMethod int
+
+const (
+	// KEEP_FIRST keeps the first occurrence, the following ones are duplicates.
+	KEEP_FIRST KeepMethod = 0
+
+	// KEEP_LAST keeps the last occurrence, the preceding ones are duplicates.
+	KEEP_LAST KeepMethod = 1
+
+	// KEEP_NONE marks all the occurrences as duplicates.
+	KEEP_NONE KeepMethod = 2
+)
+
+// Unique returns a new series with distinct values in order of their first
+// appearance. Values are hashed if possible, otherwise they are compared by
+// IsEqualFunc.
+func (s *Series[T]) Unique(options ...Options) *Series[T] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	keys := newKeyIndex(s.IsEqualAnyFunc)
+
+	ns := s.emptyCopy(0).(*Series[T])
+	for _, v := range s.Values {
+		if _, added := keys.add(v); added {
+			ns.Values = append(ns.Values, v)
+		}
+	}
+
+	return ns
+}
+
+// NUnique returns the number of distinct values.
+func (s *Series[T]) NUnique(options ...Options) int {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	keys := newKeyIndex(s.IsEqualAnyFunc)
+	for _, v := range s.Values {
+		keys.add(v)
+	}
+
+	return keys.len()
+}
+
+// ValueCounts returns a dataframe with distinct values (the series of the same
+// name as s) and their frequencies (the series "count", or "count_count" if s
+// is named "count"). Rows are sorted by count in descending order, values of
+// equal counts are in order of their first appearance.
+//
+// Example:
+//
+//	counts := symbols.ValueCounts()
+//
+func (s *Series[T]) ValueCounts(options ...Options) *DataFrame {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	keys := newKeyIndex(s.IsEqualAnyFunc)
+	counts, rows := []int{}, []int{}
+
+	for row, v := range s.Values {
+		id, added := keys.add(v)
+		if added {
+			counts = append(counts, 0)
+			rows = append(rows, row)
+		}
+		counts[id]++
+	}
+
+	order := make([]int, len(counts))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return counts[order[i]] > counts[order[j]]
+	})
+
+	vals := s.emptyCopy(len(order)).(*Series[T])
+	name := "count"
+	if s.name == name {
+		name = s.name + "_count"
+	}
+
+	cnts := NewSeries[int](name, &SeriesInit{Capacity: len(order)})
+
+	for _, id := range order {
+		vals.Values = append(vals.Values, s.Values[rows[id]])
+		cnts.Values = append(cnts.Values, counts[id])
+	}
+
+	return NewDataFrame(vals, cnts)
+}
+
+// Duplicated returns a series of flags which are true for duplicate rows. Rows are
+// duplicate if values of all the series in subset are equal. If subset is empty,
+// all the series are compared.
+//
+// Example:
+//
+//	dup, err := df.Duplicated([]string { "time", "symbol" }, KEEP_LAST)
+//
+func (df *DataFrame) Duplicated(subset []string, keep KeepMethod, options ...Options) (*Series[bool], error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock()
+		defer df.lock.RUnlock()
+	}
+
+	flags, err := df.duplicated(subset, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSeries("duplicated", nil, flags...), nil
+}
+
+// DropDuplicates removes duplicate rows (see Duplicated). If FilterOptions are set as
+// `FilterOptions { InPlace: true }` then dataframe is modified, otherwise new dataframe
+// is returned.
+//
+// Example:
+//
+//	df, err := df.DropDuplicates(ctx, []string { "time" }, KEEP_LAST)
+//
+func (df *DataFrame) DropDuplicates(ctx context.Context, subset []string, keep KeepMethod, options ...FilterOptions) (*DataFrame, error) {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		if opts.InPlace {
+			df.lock.Lock()
+			defer df.lock.Unlock()
+		} else {
+			df.lock.RLock()
+			defer df.lock.RUnlock()
+		}
+	}
+
+	flags, err := df.duplicated(subset, keep)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterDataFrame(ctx, df, func(vals map[string]any, row, nRows int) (FilterAction, error) {
+		if flags[row] {
+			return DROP, nil
+		}
+		return KEEP, nil
+	}, FilterOptions{InPlace: opts.InPlace, DontLock: true})
+}
+
+func (df *DataFrame) duplicated(subset []string, keep KeepMethod) ([]bool, error) {
+	if len(subset) == 0 {
+		subset = df.Names(dontLock)
+	}
+
+	series := make([]SeriesAny, 0, len(subset))
+	for _, name := range subset {
+		col, err := df.NameToColumn(name, dontLock)
+		if err != nil {
+			return nil, errors.New(err.Error() + ": " + name)
+		}
+		series = append(series, df.Series[col])
+	}
+
+	// every value is replaced by id of the value in its series, ids of
+	// the row form the key of the row
+	ids := make([]*keyIndex, len(series))
+	for i, s := range series {
+		ids[i] = newKeyIndex(s.IsEqualAnyFunc)
+	}
+
+	rowKeys := make([]string, df.n)
+	counts := map[string]int{}
+
+	var b strings.Builder
+
+	for row := 0; row < df.n; row++ {
+		b.Reset()
+		for i, s := range series {
+			id, _ := ids[i].add(s.ValueAny(row, dontLock))
+			b.WriteString(strconv.Itoa(id))
+			b.WriteByte(',')
+		}
+		rowKeys[row] = b.String()
+		counts[rowKeys[row]]++
+	}
+
+	flags := make([]bool, df.n)
+	seen := map[string]int{}
+
+	for row, key := range rowKeys {
+		seen[key]++
+
+		switch keep {
+		case KEEP_FIRST:
+			flags[row] = seen[key] > 1
+		case KEEP_LAST:
+			flags[row] = seen[key] < counts[key]
+		case KEEP_NONE:
+			flags[row] = counts[key] > 1
+		default:
+			return nil, fmt.Errorf("unknown keep method: %d", keep)
+		}
+	}
+
+	return flags, nil
+}
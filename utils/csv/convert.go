@@ -38,8 +38,12 @@ func NewConverter[T any](fn ConverterFn[T]) Converter[T] {
 	return Converter[T] { fn }
 }
 
-// Interface CovnverterAny function for auto instantiation series of type T
+// Interface CovnverterAny function for auto instantiation series of type T. Series
+// of registered types get comparators and formatter of the type.
 func (c Converter[T]) series(name string, init *dataframe.SeriesInit) dataframe.SeriesAny {
+	if rs, err := dataframe.NewSeriesFromType(dataframe.TypeName[T](), name, init); err == nil {
+		return rs
+	}
 	return dataframe.NewSeries[T](name, init)
 }
 
@@ -48,3 +52,57 @@ func (c Converter[T]) value(s string) any {
 	return c.fn(s)
 }
 
+
+// Nullable creates converter of pointers to type T. Empty strings and "nil" (the default
+// NullString of Export) are converted to nil, other values are converted by c.
+//
+// Example:
+//
+//	var NullFloat64 = csv.Nullable(csv.Float64)
+func Nullable[T any](c Converter[T]) Converter[*T] {
+	return NewConverter(func(s string) *T {
+		if s == "" || s == "nil" {
+			return nil
+		}
+		v := c.fn(s)
+		return &v
+	})
+}
+
+// CategoricalConverter converts strings into values of dataframe.Categorical
+// series. Empty strings are converted to nulls.
+type CategoricalConverter struct {
+	categories []string
+	ordered    bool
+}
+
+// NewCategoricalConverter creates converter into categorical series with initial
+// categories. Values which are not in categories are added as new categories.
+//
+// Example:
+//
+//	df, err := csv.Load(ctx, reader, map[string]csv.ConverterAny {
+//		"side": csv.NewCategoricalConverter([]string { "buy", "sell" }, false),
+//	})
+func NewCategoricalConverter(categories []string, ordered bool) CategoricalConverter {
+	return CategoricalConverter{categories, ordered}
+}
+
+// Interface ConverterAny function for auto instantiation of categorical series
+func (c CategoricalConverter) series(name string, init *dataframe.SeriesInit) dataframe.SeriesAny {
+	return dataframe.NewCategorical(name, &dataframe.CategoricalInit{
+		Size:       init.Size,
+		Capacity:   init.Capacity,
+		MaxLen:     init.MaxLen,
+		Categories: c.categories,
+		Ordered:    c.ordered,
+	})
+}
+
+// Interface ConverterAny function to convert string into categorical value
+func (c CategoricalConverter) value(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -3,6 +3,8 @@ package csv
 import (
 	"strconv"
 	"time"
+
+	"github.com/tradeoforigin/dataframe-go/utils/decimal"
 )
 
 // CSV converter for string types
@@ -148,3 +150,9 @@ var Time = NewConverter(
 	},
 )
 
+
+// CSV converter for categorical series with categories in order of appearance
+var Categorical = NewCategoricalConverter(nil, false)
+
+// CSV converter for decimal.Decimal types
+var Decimal = NewConverter(decimal.MustParse)
@@ -38,6 +38,8 @@ type LoadOptions struct {
 // for specific series. Series defined in converters will be under the same name in resulted dataframe. If
 // LoadOptions headers field is not set, the CSV file must contains header line at the first place, otherwise
 // error is returned. 
+// Series of types registered by dataframe.RegisterType get comparators and formatter of the type,
+// series of other types are created by dataframe.NewSeries.
 // 
 // Example:
 //
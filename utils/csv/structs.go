@@ -0,0 +1,115 @@
+package csv
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// converters used by LoadInto for fields of particular type
+var typeConverters = map[string]ConverterAny{
+	"string":     String,
+	"float64":    Float64,
+	"float32":    Float32,
+	"int64":      Int64,
+	"int32":      Int32,
+	"int":        Int,
+	"uint64":     UInt64,
+	"uint32":     UInt32,
+	"uint":       UInt,
+	"bool":       Bool,
+	"complex128": Complex128,
+	"complex64":  Complex64,
+	"time.Time":  Time,
+
+	"*string":     Nullable(String),
+	"*float64":    Nullable(Float64),
+	"*float32":    Nullable(Float32),
+	"*int64":      Nullable(Int64),
+	"*int32":      Nullable(Int32),
+	"*int":        Nullable(Int),
+	"*uint64":     Nullable(UInt64),
+	"*uint32":     Nullable(UInt32),
+	"*uint":       Nullable(UInt),
+	"*bool":       Nullable(Bool),
+	"*complex128": Nullable(Complex128),
+	"*complex64":  Nullable(Complex64),
+	"*time.Time":  Nullable(Time),
+}
+
+// Function to load CSV data directly into slice of structs. Columns are mapped to fields of T
+// in the same way as in dataframe.FromStructs, so the `dataframe:"name"` tag defines the column
+// name. Converters are chosen by the field type, pointer fields are loaded as nil for empty
+// values. Fields without column are left with zero value.
+//
+// Example:
+//
+//	type Bar struct {
+//		Time  time.Time `dataframe:"time"`
+//		Close float64   `dataframe:"c"`
+//		Note  *string   `dataframe:"note"`
+//	}
+//
+//	bars, err := csv.LoadInto[Bar](ctx, reader)
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func LoadInto[T any](ctx context.Context, r io.ReadSeeker, options ...LoadOptions) ([]T, error) {
+	opts := dataframe.DefaultOptions(options...)
+
+	schema, err := dataframe.StructSchema[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	headers := opts.Headers
+
+	// read header line to know which fields are present
+	if len(headers) == 0 {
+		cr := csv.NewReader(r)
+		cr.Comma = opts.Comma
+		if cr.Comma == 0 {
+			cr.Comma = ','
+		}
+		cr.Comment = opts.Comment
+		cr.TrimLeadingSpace = opts.TrimLeadingSpace
+
+		headers, err = cr.Read()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	columns := map[string]bool{}
+	for _, h := range headers {
+		columns[h] = true
+	}
+
+	converters := map[string]ConverterAny{}
+	for _, field := range schema {
+		if !columns[field.Name] {
+			continue
+		}
+
+		converter, ok := typeConverters[field.Type]
+		if !ok {
+			return nil, errors.New("no converter for field type: " + field.Type)
+		}
+		converters[field.Name] = converter
+	}
+
+	df, err := Load(ctx, r, converters, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataframe.ToStructs[T](df)
+}
@@ -0,0 +1,350 @@
+// Package decimal implements fixed-point decimal numbers for exact monetary
+// computations and their series.
+package decimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// MaxScale is the maximal number of digits after the decimal point.
+const MaxScale = 18
+
+var errOverflow = errors.New("decimal overflow")
+
+var pow10 = func() [MaxScale + 1]int64 {
+	var p [MaxScale + 1]int64
+	p[0] = 1
+	for i := 1; i <= MaxScale; i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// Decimal is a fixed-point decimal number: mantissa * 10^-scale. The zero value
+// is 0. Arithmetic operations are exact, they panic if the result does not fit
+// into int64 mantissa or MaxScale.
+type Decimal struct {
+	mantissa int64
+	scale    int32
+}
+
+// New creates decimal mantissa * 10^-scale.
+//
+// Example:
+//
+//	price := decimal.New(12345, 2) // 123.45
+//
+func New(mantissa int64, scale int32) Decimal {
+	checkScale(scale)
+	return Decimal{mantissa, scale}
+}
+
+// FromInt creates decimal of integer i.
+func FromInt(i int64) Decimal {
+	return Decimal{i, 0}
+}
+
+// FromFloat creates decimal of f rounded to scale digits by mode.
+func FromFloat(f float64, scale int32, mode RoundingMode) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		panic("invalid decimal: " + strconv.FormatFloat(f, 'g', -1, 64))
+	}
+
+	checkScale(scale)
+
+	// the shortest decimal representation of f, so 0.1 is exactly 0.1
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+
+	n := new(big.Int).Mul(r.Num(), big.NewInt(pow10[scale]))
+	return fromBig(divRound(n, r.Denom(), mode), scale)
+}
+
+// Parse parses decimal from a string like "-123.45". The scale is the number of
+// digits after the decimal point.
+func Parse(s string) (Decimal, error) {
+	str := s
+
+	neg := false
+	switch {
+	case strings.HasPrefix(str, "-"):
+		neg, str = true, str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+
+	if intPart == "" && fracPart == "" || len(fracPart) > MaxScale {
+		return Decimal{}, errors.New("invalid decimal: " + s)
+	}
+
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return Decimal{}, errors.New("invalid decimal: " + s)
+		}
+	}
+
+	m, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return Decimal{}, errors.New("invalid decimal: " + s)
+	}
+
+	if neg {
+		m = -m
+	}
+
+	return Decimal{m, int32(len(fracPart))}, nil
+}
+
+// MustParse is like Parse but panics if s is not a valid decimal.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Mantissa returns the mantissa of d.
+func (d Decimal) Mantissa() int64 {
+	return d.mantissa
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or 1 for negative, zero or positive d.
+func (d Decimal) Sign() int {
+	switch {
+	case d.mantissa < 0:
+		return -1
+	case d.mantissa > 0:
+		return 1
+	}
+	return 0
+}
+
+// IsZero returns true if d is 0.
+func (d Decimal) IsZero() bool {
+	return d.mantissa == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	if d.mantissa == math.MinInt64 {
+		panic(errOverflow)
+	}
+	return Decimal{-d.mantissa, d.scale}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	if d.mantissa < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// Add returns d + d2. The scale of the result is the greater of the scales.
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+
+	r := a.mantissa + b.mantissa
+	if (a.mantissa > 0 && b.mantissa > 0 && r < 0) || (a.mantissa < 0 && b.mantissa < 0 && r >= 0) {
+		panic(errOverflow)
+	}
+
+	return Decimal{r, a.scale}
+}
+
+// Sub returns d - d2. The scale of the result is the greater of the scales.
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+
+	// not d.Add(d2.Neg()), -d2 does not fit if d2 is the minimal mantissa
+	r := a.mantissa - b.mantissa
+	if (a.mantissa >= 0 && b.mantissa < 0 && r < 0) || (a.mantissa < 0 && b.mantissa > 0 && r >= 0) {
+		panic(errOverflow)
+	}
+
+	return Decimal{r, a.scale}
+}
+
+// Mul returns d * d2. The scale of the result is the sum of the scales.
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	scale := d.scale + d2.scale
+	if scale > MaxScale {
+		panic(errOverflow)
+	}
+
+	return Decimal{mul(d.mantissa, d2.mantissa), scale}
+}
+
+// Div returns d / d2 rounded to scale digits by mode. It panics if d2 is 0.
+//
+// Example:
+//
+//	avg := total.Div(decimal.FromInt(n), 4, decimal.HALF_EVEN)
+//
+func (d Decimal) Div(d2 Decimal, scale int32, mode RoundingMode) Decimal {
+	if d2.mantissa == 0 {
+		panic("division by zero")
+	}
+
+	checkScale(scale)
+
+	// d.m * 10^(scale - d.scale + d2.scale) / d2.m
+	exp := int64(scale) - int64(d.scale) + int64(d2.scale)
+
+	n := big.NewInt(d.mantissa)
+	den := big.NewInt(d2.mantissa)
+
+	ten := big.NewInt(10)
+	if exp >= 0 {
+		n.Mul(n, new(big.Int).Exp(ten, big.NewInt(exp), nil))
+	} else {
+		den.Mul(den, new(big.Int).Exp(ten, big.NewInt(-exp), nil))
+	}
+
+	return fromBig(divRound(n, den, mode), scale)
+}
+
+// Rescale returns d with scale digits after the decimal point. Digits are
+// rounded by mode if scale is lower than the scale of d.
+//
+// Example:
+//
+//	decimal.MustParse("1.2350").Rescale(2, decimal.HALF_EVEN) // 1.24
+//
+func (d Decimal) Rescale(scale int32, mode RoundingMode) Decimal {
+	checkScale(scale)
+
+	if scale >= d.scale {
+		return Decimal{mul(d.mantissa, pow10[scale-d.scale]), scale}
+	}
+
+	m := divRound(big.NewInt(d.mantissa), big.NewInt(pow10[d.scale-scale]), mode)
+	return fromBig(m, scale)
+}
+
+// Cmp compares d and d2 and returns -1, 0 or 1 if d is less than, equal to or
+// greater than d2.
+func (d Decimal) Cmp(d2 Decimal) int {
+	if d.scale == d2.scale {
+		switch {
+		case d.mantissa < d2.mantissa:
+			return -1
+		case d.mantissa > d2.mantissa:
+			return 1
+		}
+		return 0
+	}
+
+	// scales may overflow int64 when aligned
+	a := new(big.Int).Mul(big.NewInt(d.mantissa), big.NewInt(pow10[d2.scale]))
+	b := new(big.Int).Mul(big.NewInt(d2.mantissa), big.NewInt(pow10[d.scale]))
+	return a.Cmp(b)
+}
+
+// Equal returns true if d and d2 represent the same number regardless of scale.
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// HashKey returns d without trailing zeros, so equal decimals of different
+// scales have equal keys. It implements dataframe.HashKeyer.
+func (d Decimal) HashKey() any {
+	for d.scale > 0 && d.mantissa%10 == 0 {
+		d.mantissa /= 10
+		d.scale--
+	}
+	return d
+}
+
+// Float64 returns the nearest float64 value of d.
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(d.mantissa), big.NewInt(pow10[d.scale])).Float64()
+	return f
+}
+
+// String returns d with Scale() digits after the decimal point.
+func (d Decimal) String() string {
+	s := strconv.FormatInt(d.mantissa, 10)
+	if d.scale == 0 {
+		return s
+	}
+
+	neg := d.mantissa < 0
+	if neg {
+		s = s[1:]
+	}
+
+	if len(s) <= int(d.scale) {
+		s = strings.Repeat("0", int(d.scale)-len(s)+1) + s
+	}
+
+	s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	if neg {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// checkScale panics if scale is out of range
+func checkScale(scale int32) {
+	if scale < 0 || scale > MaxScale {
+		panic("scale must be in range [0, 18]: " + strconv.Itoa(int(scale)))
+	}
+}
+
+// align returns d and d2 rescaled to the same scale
+func align(d, d2 Decimal) (Decimal, Decimal) {
+	switch {
+	case d.scale < d2.scale:
+		d = Decimal{mul(d.mantissa, pow10[d2.scale-d.scale]), d2.scale}
+	case d.scale > d2.scale:
+		d2 = Decimal{mul(d2.mantissa, pow10[d.scale-d2.scale]), d.scale}
+	}
+	return d, d2
+}
+
+// mul returns a * b and panics on overflow
+func mul(a, b int64) int64 {
+	neg := (a < 0) != (b < 0)
+
+	hi, lo := bits.Mul64(abs(a), abs(b))
+	if hi != 0 || (lo > math.MaxInt64 && !(neg && lo == 1<<63)) {
+		panic(errOverflow)
+	}
+
+	if neg {
+		return -int64(lo)
+	}
+	return int64(lo)
+}
+
+func abs(a int64) uint64 {
+	if a < 0 {
+		return uint64(-a)
+	}
+	return uint64(a)
+}
+
+// fromBig creates decimal of big mantissa and panics on overflow
+func fromBig(m *big.Int, scale int32) Decimal {
+	if !m.IsInt64() {
+		panic(errOverflow)
+	}
+	return Decimal{m.Int64(), scale}
+}
@@ -0,0 +1,75 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode defines how digits are discarded when a decimal is rounded.
+type RoundingMode int
+
+const (
+	// HALF_EVEN rounds to the nearest neighbour, ties to the even one (banker's rounding).
+	HALF_EVEN RoundingMode = 0
+
+	// HALF_UP rounds to the nearest neighbour, ties away from zero.
+	HALF_UP RoundingMode = 1
+
+	// HALF_DOWN rounds to the nearest neighbour, ties towards zero.
+	HALF_DOWN RoundingMode = 2
+
+	// UP rounds away from zero.
+	UP RoundingMode = 3
+
+	// DOWN rounds towards zero (truncation).
+	DOWN RoundingMode = 4
+
+	// CEILING rounds towards positive infinity.
+	CEILING RoundingMode = 5
+
+	// FLOOR rounds towards negative infinity.
+	FLOOR RoundingMode = 6
+)
+
+// divRound returns n / d rounded by mode
+func divRound(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	// sign of the exact result
+	sign := n.Sign() * d.Sign()
+
+	// comparison of the remainder with the half of d
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	cmp := half.Cmp(new(big.Int).Abs(d))
+
+	var away bool
+
+	switch mode {
+	case HALF_EVEN:
+		away = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+	case HALF_UP:
+		away = cmp >= 0
+	case HALF_DOWN:
+		away = cmp > 0
+	case UP:
+		away = true
+	case DOWN:
+		away = false
+	case CEILING:
+		away = sign > 0
+	case FLOOR:
+		away = sign < 0
+	default:
+		panic(fmt.Sprintf("unknown rounding mode: %d", mode))
+	}
+
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+
+	return q
+}
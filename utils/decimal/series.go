@@ -0,0 +1,109 @@
+package decimal
+
+import (
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// IsEqual returns true if a and b represent the same number. It can be used
+// by SetIsEqualFunc.
+func IsEqual(a, b Decimal) bool {
+	return a.Equal(b)
+}
+
+// IsLessThan returns true if a is less than b. It can be used by SetIsLessThanFunc.
+func IsLessThan(a, b Decimal) bool {
+	return a.Cmp(b) < 0
+}
+
+// IsEqualPtr returns true if a and b are nil or represent the same number.
+func IsEqualPtr(a, b *Decimal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// IsLessThanPtr returns true if a is less than b, nil is less than any number.
+func IsLessThanPtr(a, b *Decimal) bool {
+	if a == nil {
+		return b != nil
+	}
+	if b == nil {
+		return false
+	}
+	return IsLessThan(*a, *b)
+}
+
+// Formatter formats Decimal and *Decimal values. It can be used by
+// SetValueToStringFormatter.
+func Formatter(v any) string {
+	switch d := v.(type) {
+	case Decimal:
+		return d.String()
+	case *Decimal:
+		if d != nil {
+			return d.String()
+		}
+	}
+	return dataframe.DefaultValueFormatter(nil)
+}
+
+// NewSeries creates a series of decimals with comparators and formatter of
+// the decimal type.
+//
+// Example:
+//
+//	prices := decimal.NewSeries("price", nil, decimal.MustParse("1.05"), decimal.MustParse("1.10"))
+//
+func NewSeries(name string, init *dataframe.SeriesInit, vals ...Decimal) *dataframe.Series[Decimal] {
+	s := dataframe.NewSeries(name, init, vals...)
+	s.SetIsEqualFunc(IsEqual)
+	s.SetIsLessThanFunc(IsLessThan)
+	s.SetValueToStringFormatter(Formatter)
+	return s
+}
+
+// Sum returns the exact sum of values of the series.
+func Sum(s *dataframe.Series[Decimal], options ...dataframe.Options) Decimal {
+	opts := dataframe.DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	var sum Decimal
+	for _, v := range s.Values {
+		sum = sum.Add(v)
+	}
+
+	return sum
+}
+
+// Mean returns the mean of values of the series rounded to scale digits by mode.
+// The mean of an empty series is 0.
+func Mean(s *dataframe.Series[Decimal], scale int32, mode RoundingMode, options ...dataframe.Options) Decimal {
+	opts := dataframe.DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	if len(s.Values) == 0 {
+		return New(0, scale)
+	}
+
+	return Sum(s, dataframe.Options{DontLock: true}).Div(FromInt(int64(len(s.Values))), scale, mode)
+}
+
+func init() {
+	dataframe.RegisterType(dataframe.TypeOptions[Decimal]{
+		IsEqualFunc:    IsEqual,
+		IsLessThanFunc: IsLessThan,
+		ValueFormatter: Formatter,
+	})
+	dataframe.RegisterType(dataframe.TypeOptions[*Decimal]{
+		IsEqualFunc:    IsEqualPtr,
+		IsLessThanFunc: IsLessThanPtr,
+		ValueFormatter: Formatter,
+	})
+}
@@ -0,0 +1,148 @@
+package online
+
+import (
+	"math"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// RSI is an online relative strength index with Wilder's smoothing of
+// average gains and losses.
+type RSI struct {
+	period int
+
+	n                 int
+	prev              float64
+	avgGain, avgLoss  float64
+}
+
+// NewRSI creates online relative strength index of period.
+func NewRSI(period int) *RSI {
+	checkPeriod(period)
+	return &RSI{period: period}
+}
+
+// Update adds the value v and returns the current index.
+func (r *RSI) Update(v float64) float64 {
+	if math.IsNaN(v) {
+		return r.Value()
+	}
+
+	if r.n == 0 {
+		r.n, r.prev = 1, v
+		return r.Value()
+	}
+
+	gain, loss := math.Max(v - r.prev, 0), math.Max(r.prev - v, 0)
+	r.prev = v
+
+	if r.n <= r.period {
+		// simple averages of the first period changes
+		r.avgGain += (gain - r.avgGain) / float64(r.n)
+		r.avgLoss += (loss - r.avgLoss) / float64(r.n)
+		r.n++
+	} else {
+		p := float64(r.period)
+		r.avgGain = (r.avgGain * (p - 1) + gain) / p
+		r.avgLoss = (r.avgLoss * (p - 1) + loss) / p
+	}
+
+	return r.Value()
+}
+
+// Value returns the current index in range [0, 100], NaN until period + 1
+// values are added.
+func (r *RSI) Value() float64 {
+	if r.n <= r.period {
+		return math.NaN()
+	}
+
+	if r.avgLoss == 0 {
+		if r.avgGain == 0 {
+			return 50
+		}
+		return 100
+	}
+
+	return 100 - 100 / (1 + r.avgGain / r.avgLoss)
+}
+
+// Reset clears the state of the index.
+func (r *RSI) Reset() {
+	r.n, r.prev, r.avgGain, r.avgLoss = 0, 0, 0, 0
+}
+
+// ATR is an online average true range with Wilder's smoothing. It is updated
+// by bars, so it does not implement Calculator.
+type ATR struct {
+	period int
+
+	n         int
+	prevClose float64
+	value     float64
+}
+
+// NewATR creates online average true range of period.
+func NewATR(period int) *ATR {
+	checkPeriod(period)
+	return &ATR{period: period}
+}
+
+// UpdateBar adds the bar and returns the current average true range. Bars with
+// NaN values are ignored.
+func (a *ATR) UpdateBar(high, low, close float64) float64 {
+	if math.IsNaN(high) || math.IsNaN(low) || math.IsNaN(close) {
+		return a.Value()
+	}
+
+	tr := high - low
+	if a.n > 0 {
+		tr = math.Max(tr, math.Max(math.Abs(high - a.prevClose), math.Abs(low - a.prevClose)))
+	}
+	a.prevClose = close
+
+	if a.n < a.period {
+		a.n++
+		a.value += (tr - a.value) / float64(a.n)
+	} else {
+		p := float64(a.period)
+		a.value = (a.value * (p - 1) + tr) / p
+	}
+
+	return a.Value()
+}
+
+// Value returns the current average true range, NaN until period bars are added.
+func (a *ATR) Value() float64 {
+	if a.n < a.period {
+		return math.NaN()
+	}
+	return a.value
+}
+
+// Reset clears the state of the average true range.
+func (a *ATR) Reset() {
+	a.n, a.prevClose, a.value = 0, 0, 0
+}
+
+// Seed updates a by all bars of high, low and close series and returns the
+// current average true range. Series must have the same number of rows.
+func (a *ATR) Seed(high, low, close *dataframe.Series[float64], options ...dataframe.Options) float64 {
+	opts := dataframe.DefaultOptions(options...)
+
+	if !opts.DontLock {
+		high.RLock(); defer high.RUnlock()
+		low.RLock(); defer low.RUnlock()
+		close.RLock(); defer close.RUnlock()
+	}
+
+	if len(high.Values) != len(low.Values) || len(high.Values) != len(close.Values) {
+		panic("different number of rows in series")
+	}
+
+	for i := range high.Values {
+		a.UpdateBar(high.Values[i], low.Values[i], close.Values[i])
+	}
+
+	return a.Value()
+}
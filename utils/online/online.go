@@ -0,0 +1,148 @@
+// Package online implements stateful calculators of statistics and indicators
+// which are updated in O(1) per value, so they can follow live series without
+// recomputation over all values.
+package online
+
+import (
+	"math"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// Calculator is a stateful online calculator. NaN values are ignored by Update.
+type Calculator interface {
+	// Update adds the value v and returns the current result.
+	Update(v float64) float64
+
+	// Value returns the current result. It is NaN until the calculator has
+	// enough values.
+	Value() float64
+
+	// Reset clears the state of the calculator.
+	Reset()
+}
+
+// Seed updates c by all values of s and returns the current result.
+//
+// Example:
+//
+//	ema := online.NewEMA(200)
+//	online.Seed(ema, closes)
+//
+func Seed(c Calculator, s *dataframe.Series[float64], options ...dataframe.Options) float64 {
+	opts := dataframe.DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	for _, v := range s.Values {
+		c.Update(v)
+	}
+
+	return c.Value()
+}
+
+// Attach seeds c by values of src and returns a derived series of name with
+// results of c for every row of src. Values appended to src are passed to c
+// and its results are appended to the derived series automatically. The
+// derived series is capped as src. Attaching stops by Unsubscribe of the
+// returned subscription.
+//
+// Online calculators can't remove or reorder values, so other changes of src
+// (Insert, Update, Remove, Sort, Swap, sorting of its DataFrame or a committed
+// transaction) reset c and recompute the whole derived series once per change.
+// Each of them takes O(n), so Attach is meant for series which are mostly
+// appended to.
+//
+// Example:
+//
+//	ema, sub := online.Attach(online.NewEMA(200), closes, "ema200")
+//	defer sub.Unsubscribe()
+//
+//	closes.Append([]float64 { 1.2345 })
+//	ema.Value(-1) // EMA including the new value
+//
+func Attach(c Calculator, src *dataframe.Series[float64], name string) (*dataframe.Series[float64], *dataframe.Subscription[float64]) {
+	src.RLock(); defer src.RUnlock()
+
+	out := dataframe.NewSeries[float64](name, &dataframe.SeriesInit{
+		Capacity: src.NRows(dataframe.DontLock),
+		MaxLen:   src.MaxLen(dataframe.DontLock),
+	})
+
+	compute(c, src.Values, out)
+
+	// events of changes made after the subscription are delivered after
+	// the lock of src is released
+	sub := src.Subscribe(func(ev dataframe.Event[float64]) {
+		switch ev.Kind {
+		case dataframe.EVENT_APPEND:
+			compute(c, ev.Values, out)
+		case dataframe.EVENT_EVICT:
+			// the derived series evicts its rows itself
+		case dataframe.EVENT_RESET:
+			c.Reset()
+			out.Reset()
+		case dataframe.EVENT_INSERT, dataframe.EVENT_UPDATE, dataframe.EVENT_REMOVE,
+			dataframe.EVENT_SORT, dataframe.EVENT_REPLACE:
+			c.Reset()
+			out.Reset()
+
+			src.RLock()
+			compute(c, src.Values, out)
+			src.RUnlock()
+		}
+	})
+
+	return out, sub
+}
+
+// compute updates c by vals and appends the results to out
+func compute(c Calculator, vals []float64, out *dataframe.Series[float64]) {
+	res := make([]float64, len(vals))
+	for i, v := range vals {
+		res[i] = c.Update(v)
+	}
+
+	out.Append(res)
+}
+
+// ring is a fixed-size window of the last values
+type ring struct {
+	vals []float64
+	head int
+	n    int
+}
+
+func newRing(size int) ring {
+	return ring{vals: make([]float64, size)}
+}
+
+// push adds v and returns the value removed from the full window
+func (r *ring) push(v float64) (float64, bool) {
+	if r.n < len(r.vals) {
+		r.vals[(r.head + r.n) % len(r.vals)] = v
+		r.n++
+		return math.NaN(), false
+	}
+
+	old := r.vals[r.head]
+	r.vals[r.head] = v
+	r.head = (r.head + 1) % len(r.vals)
+	return old, true
+}
+
+func (r *ring) full() bool {
+	return r.n == len(r.vals)
+}
+
+func (r *ring) reset() {
+	r.head, r.n = 0, 0
+}
+
+func checkPeriod(period int) {
+	if period < 1 {
+		panic("period must be greater than 0")
+	}
+}
@@ -0,0 +1,248 @@
+package online
+
+import (
+	"math"
+)
+
+// Mean is an online mean of all values or of the last window values.
+type Mean struct {
+	window ring
+	n      int
+	mean   float64
+}
+
+// NewMean creates online mean of the last window values. If window is 0,
+// the mean of all values is calculated.
+func NewMean(window int) *Mean {
+	if window < 0 {
+		panic("window must not be negative")
+	}
+	return &Mean{window: newRing(window)}
+}
+
+// Update adds the value v and returns the current mean.
+func (m *Mean) Update(v float64) float64 {
+	if math.IsNaN(v) {
+		return m.Value()
+	}
+
+	if len(m.window.vals) > 0 {
+		if old, ok := m.window.push(v); ok {
+			m.mean += (v - old) / float64(m.n)
+			return m.mean
+		}
+	}
+
+	m.n++
+	m.mean += (v - m.mean) / float64(m.n)
+	return m.mean
+}
+
+// Value returns the current mean, NaN if there are no values.
+func (m *Mean) Value() float64 {
+	if m.n == 0 {
+		return math.NaN()
+	}
+	return m.mean
+}
+
+// Reset clears the state of the mean.
+func (m *Mean) Reset() {
+	m.window.reset()
+	m.n, m.mean = 0, 0
+}
+
+// Variance is an online variance of all values or of the last window values
+// calculated by Welford's algorithm.
+type Variance struct {
+	window ring
+	ddof   int
+
+	n        int
+	mean, m2 float64
+}
+
+// NewVariance creates online variance of the last window values. If window is 0,
+// the variance of all values is calculated. The divisor is n - ddof, so ddof
+// 0 is the population and 1 the sample variance.
+func NewVariance(window, ddof int) *Variance {
+	if window < 0 {
+		panic("window must not be negative")
+	}
+	if ddof < 0 {
+		panic("ddof must not be negative")
+	}
+	return &Variance{window: newRing(window), ddof: ddof}
+}
+
+// Update adds the value v and returns the current variance.
+func (va *Variance) Update(v float64) float64 {
+	if math.IsNaN(v) {
+		return va.Value()
+	}
+
+	if len(va.window.vals) > 0 {
+		if old, ok := va.window.push(v); ok {
+			// replacement of old by v in the window
+			mean := va.mean + (v - old) / float64(va.n)
+			va.m2 += (v - old) * (v - mean + old - va.mean)
+			va.mean = mean
+
+			if va.m2 < 0 {
+				va.m2 = 0
+			}
+			return va.Value()
+		}
+	}
+
+	va.n++
+	delta := v - va.mean
+	va.mean += delta / float64(va.n)
+	va.m2 += delta * (v - va.mean)
+
+	return va.Value()
+}
+
+// Value returns the current variance, NaN if there are not more than ddof values.
+func (va *Variance) Value() float64 {
+	if va.n <= va.ddof {
+		return math.NaN()
+	}
+	return va.m2 / float64(va.n - va.ddof)
+}
+
+// Std returns the current standard deviation.
+func (va *Variance) Std() float64 {
+	return math.Sqrt(va.Value())
+}
+
+// Mean returns the current mean, NaN if there are no values.
+func (va *Variance) Mean() float64 {
+	if va.n == 0 {
+		return math.NaN()
+	}
+	return va.mean
+}
+
+// Reset clears the state of the variance.
+func (va *Variance) Reset() {
+	va.window.reset()
+	va.n, va.mean, va.m2 = 0, 0, 0
+}
+
+// EMA is an online exponential moving average with smoothing factor
+// 2 / (period + 1). It is seeded by the simple average of the first period
+// values.
+type EMA struct {
+	period int
+	alpha  float64
+
+	n     int
+	value float64
+}
+
+// NewEMA creates online exponential moving average of period.
+func NewEMA(period int) *EMA {
+	checkPeriod(period)
+	return &EMA{period: period, alpha: 2 / float64(period + 1)}
+}
+
+// Update adds the value v and returns the current average.
+func (e *EMA) Update(v float64) float64 {
+	if math.IsNaN(v) {
+		return e.Value()
+	}
+
+	if e.n < e.period {
+		e.n++
+		e.value += (v - e.value) / float64(e.n)
+	} else {
+		e.value += e.alpha * (v - e.value)
+	}
+
+	return e.Value()
+}
+
+// Value returns the current average, NaN until period values are added.
+func (e *EMA) Value() float64 {
+	if e.n < e.period {
+		return math.NaN()
+	}
+	return e.value
+}
+
+// Reset clears the state of the average.
+func (e *EMA) Reset() {
+	e.n, e.value = 0, 0
+}
+
+// Rolling is an online minimum or maximum of the last window values. Values
+// are kept in a monotonic queue, so updates take amortized O(1).
+type Rolling struct {
+	window int
+	less   func(a, b float64) bool
+
+	// monotonic queue of values and their positions
+	vals []float64
+	pos  []int
+	head int
+
+	n int
+}
+
+// NewRollingMin creates online minimum of the last window values.
+func NewRollingMin(window int) *Rolling {
+	checkPeriod(window)
+	return &Rolling{window: window, less: func(a, b float64) bool { return a < b }}
+}
+
+// NewRollingMax creates online maximum of the last window values.
+func NewRollingMax(window int) *Rolling {
+	checkPeriod(window)
+	return &Rolling{window: window, less: func(a, b float64) bool { return a > b }}
+}
+
+// Update adds the value v and returns the current minimum or maximum.
+func (r *Rolling) Update(v float64) float64 {
+	if math.IsNaN(v) {
+		return r.Value()
+	}
+
+	// values which can not be extremes anymore
+	for len(r.vals) > r.head && !r.less(r.vals[len(r.vals) - 1], v) {
+		r.vals = r.vals[:len(r.vals) - 1]
+		r.pos = r.pos[:len(r.pos) - 1]
+	}
+
+	r.vals = append(r.vals, v)
+	r.pos = append(r.pos, r.n)
+	r.n++
+
+	// values out of the window
+	for r.pos[r.head] <= r.n - 1 - r.window {
+		r.head++
+	}
+
+	// drop the dequeued values when they are the greater part of the queue
+	if r.head > len(r.vals) / 2 {
+		r.vals = append(r.vals[:0], r.vals[r.head:]...)
+		r.pos = append(r.pos[:0], r.pos[r.head:]...)
+		r.head = 0
+	}
+
+	return r.vals[r.head]
+}
+
+// Value returns the current minimum or maximum, NaN if there are no values.
+func (r *Rolling) Value() float64 {
+	if len(r.vals) == r.head {
+		return math.NaN()
+	}
+	return r.vals[r.head]
+}
+
+// Reset clears the state of the calculator.
+func (r *Rolling) Reset() {
+	r.vals, r.pos = r.vals[:0], r.pos[:0]
+	r.head, r.n = 0, 0
+}
@@ -0,0 +1,383 @@
+package snapshot
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+	"github.com/tradeoforigin/dataframe-go/utils/decimal"
+)
+
+// codec encodes and decodes values of a series of particular type
+type codec interface {
+	encode(w *encoder, s dataframe.SeriesAny) error
+	decode(r *decoder, name string, nRows int) (dataframe.SeriesAny, error)
+}
+
+// codecs maps series type (as returned by SeriesAny.Type()) to codec
+var codecs = map[string]codec{}
+
+// register adds codecs for values of type T and for pointers of type *T. Nil
+// pointers are stored as nulls.
+func register[T any](write func(*encoder, T), read func(*decoder) (T, error)) {
+	c := valueCodec[T]{write, read}
+	codecs[dataframe.TypeName[T]()] = c
+	codecs[dataframe.TypeName[*T]()] = ptrCodec[T]{c}
+}
+
+func init() {
+	register(func(w *encoder, v float64) { w.uint64(math.Float64bits(v)) },
+		func(r *decoder) (float64, error) { u, err := r.uint64(); return math.Float64frombits(u), err })
+	register(func(w *encoder, v float32) { w.uint32(math.Float32bits(v)) },
+		func(r *decoder) (float32, error) { u, err := r.uint32(); return math.Float32frombits(u), err })
+	register(func(w *encoder, v int) { w.uint64(uint64(v)) },
+		func(r *decoder) (int, error) { u, err := r.uint64(); return int(u), err })
+	register(func(w *encoder, v int64) { w.uint64(uint64(v)) },
+		func(r *decoder) (int64, error) { u, err := r.uint64(); return int64(u), err })
+	register(func(w *encoder, v int32) { w.uint32(uint32(v)) },
+		func(r *decoder) (int32, error) { u, err := r.uint32(); return int32(u), err })
+	register(func(w *encoder, v int16) { w.uint16(uint16(v)) },
+		func(r *decoder) (int16, error) { u, err := r.uint16(); return int16(u), err })
+	register(func(w *encoder, v int8) { w.byte(byte(v)) },
+		func(r *decoder) (int8, error) { b, err := r.byte(); return int8(b), err })
+	register(func(w *encoder, v uint) { w.uint64(uint64(v)) },
+		func(r *decoder) (uint, error) { u, err := r.uint64(); return uint(u), err })
+	register(func(w *encoder, v uint64) { w.uint64(v) },
+		func(r *decoder) (uint64, error) { return r.uint64() })
+	register(func(w *encoder, v uint32) { w.uint32(v) },
+		func(r *decoder) (uint32, error) { return r.uint32() })
+	register(func(w *encoder, v uint16) { w.uint16(v) },
+		func(r *decoder) (uint16, error) { return r.uint16() })
+	register(func(w *encoder, v uint8) { w.byte(v) },
+		func(r *decoder) (uint8, error) { return r.byte() })
+	register(func(w *encoder, v bool) { w.bool(v) },
+		func(r *decoder) (bool, error) { return r.bool() })
+	register(func(w *encoder, v string) { w.string(v) },
+		func(r *decoder) (string, error) { return r.string() })
+	register(func(w *encoder, v complex128) {
+		w.uint64(math.Float64bits(real(v)))
+		w.uint64(math.Float64bits(imag(v)))
+	}, func(r *decoder) (complex128, error) {
+		re, err := r.uint64()
+		if err != nil {
+			return 0, err
+		}
+		im, err := r.uint64()
+		return complex(math.Float64frombits(re), math.Float64frombits(im)), err
+	})
+	register(func(w *encoder, v complex64) {
+		w.uint32(math.Float32bits(real(v)))
+		w.uint32(math.Float32bits(imag(v)))
+	}, func(r *decoder) (complex64, error) {
+		re, err := r.uint32()
+		if err != nil {
+			return 0, err
+		}
+		im, err := r.uint32()
+		return complex(math.Float32frombits(re), math.Float32frombits(im)), err
+	})
+	register(func(w *encoder, v time.Time) {
+		b, err := v.MarshalBinary()
+		if err != nil {
+			// time with offset which is not a whole number of minutes
+			panic(err)
+		}
+		w.bytes(b)
+	}, func(r *decoder) (time.Time, error) {
+		var t time.Time
+		b, err := r.bytes()
+		if err != nil {
+			return t, err
+		}
+		err = t.UnmarshalBinary(b)
+		return t, err
+	})
+
+	register(func(w *encoder, v decimal.Decimal) {
+		w.uint64(uint64(v.Mantissa()))
+		w.byte(byte(v.Scale()))
+	}, func(r *decoder) (decimal.Decimal, error) {
+		m, err := r.uint64()
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		scale, err := r.byte()
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		if scale > decimal.MaxScale {
+			return decimal.Decimal{}, errCorrupted
+		}
+		return decimal.New(int64(m), int32(scale)), nil
+	})
+
+	codecs["categorical"] = categoricalCodec{}
+}
+
+// categoricalCodec stores whether categories are ordered, the categories and
+// the codes of values (-1 for nulls)
+type categoricalCodec struct{}
+
+func (categoricalCodec) encode(w *encoder, s dataframe.SeriesAny) error {
+	c, ok := s.(*dataframe.Categorical)
+	if !ok {
+		return fmt.Errorf("unsupported series implementation: %T", s)
+	}
+
+	categories := c.Categories(dataframe.DontLock)
+
+	w.bool(c.Ordered())
+	w.uvarint(uint64(len(categories)))
+	for _, category := range categories {
+		w.string(category)
+	}
+
+	for _, code := range c.Codes(dataframe.DontLock) {
+		w.uint32(uint32(code))
+	}
+	return nil
+}
+
+func (categoricalCodec) decode(r *decoder, name string, nRows int) (dataframe.SeriesAny, error) {
+	ordered, err := r.bool()
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > math.MaxInt32 {
+		return nil, errCorrupted
+	}
+
+	categories := make([]string, n)
+	for i := range categories {
+		if categories[i], err = r.string(); err != nil {
+			return nil, err
+		}
+	}
+
+	vals := make([]any, nRows)
+	for i := range vals {
+		u, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		code := int32(u)
+		if code >= int32(len(categories)) {
+			return nil, errCorrupted
+		}
+		if code >= 0 {
+			vals[i] = categories[code]
+		}
+	}
+
+	c := dataframe.NewCategorical(name, &dataframe.CategoricalInit{
+		Capacity:   nRows,
+		Categories: categories,
+		Ordered:    ordered,
+	})
+	c.AppendAny(vals)
+	return c, nil
+}
+
+// valueCodec stores all the values of the series
+type valueCodec[T any] struct {
+	write func(*encoder, T)
+	read  func(*decoder) (T, error)
+}
+
+func (c valueCodec[T]) encode(w *encoder, s dataframe.SeriesAny) error {
+	ts, ok := s.(*dataframe.Series[T])
+	if !ok {
+		return fmt.Errorf("unsupported series implementation: %T", s)
+	}
+
+	nRows := ts.NRows(dataframe.DontLock)
+	for row := 0; row < nRows; row++ {
+		c.write(w, ts.Value(row, dataframe.DontLock))
+	}
+	return nil
+}
+
+func (c valueCodec[T]) decode(r *decoder, name string, nRows int) (dataframe.SeriesAny, error) {
+	vals := make([]T, nRows)
+	for i := range vals {
+		v, err := c.read(r)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	return newSeries(name, vals), nil
+}
+
+// ptrCodec stores null bitmap followed by the values of non-nil pointers
+type ptrCodec[T any] struct {
+	valueCodec[T]
+}
+
+func (c ptrCodec[T]) encode(w *encoder, s dataframe.SeriesAny) error {
+	ts, ok := s.(*dataframe.Series[*T])
+	if !ok {
+		return fmt.Errorf("unsupported series implementation: %T", s)
+	}
+
+	nRows := ts.NRows(dataframe.DontLock)
+
+	nulls := make([]byte, (nRows+7)/8)
+	for row := 0; row < nRows; row++ {
+		if ts.Value(row, dataframe.DontLock) == nil {
+			nulls[row/8] |= 1 << (row % 8)
+		}
+	}
+	w.buf = append(w.buf, nulls...)
+
+	for row := 0; row < nRows; row++ {
+		if v := ts.Value(row, dataframe.DontLock); v != nil {
+			c.write(w, *v)
+		}
+	}
+	return nil
+}
+
+func (c ptrCodec[T]) decode(r *decoder, name string, nRows int) (dataframe.SeriesAny, error) {
+	nulls := make([]byte, (nRows+7)/8)
+	if _, err := io.ReadFull(r.r, nulls); err != nil {
+		return nil, err
+	}
+
+	vals := make([]*T, nRows)
+	for i := range vals {
+		if nulls[i/8]&(1<<(i%8)) != 0 {
+			continue
+		}
+
+		v, err := c.read(r)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = &v
+	}
+
+	return newSeries(name, vals), nil
+}
+
+// newSeries creates series by the type registry, so the series gets
+// comparators and formatter registered for its type
+func newSeries[T any](name string, vals []T) *dataframe.Series[T] {
+	s, err := dataframe.NewSeriesFromType(dataframe.TypeName[T](), name, &dataframe.SeriesInit{Capacity: len(vals)})
+	if err != nil {
+		return dataframe.NewSeries(name, nil, vals...)
+	}
+
+	ts := s.(*dataframe.Series[T])
+	ts.Append(vals)
+	return ts
+}
+
+// encoder writes little endian values into the column buffer
+type encoder struct {
+	buf     []byte
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (w *encoder) byte(v byte) { w.buf = append(w.buf, v) }
+
+func (w *encoder) bool(v bool) {
+	if v {
+		w.byte(1)
+	} else {
+		w.byte(0)
+	}
+}
+
+func (w *encoder) uint16(v uint16) {
+	w.buf = append(w.buf, byte(v), byte(v>>8))
+}
+
+func (w *encoder) uint32(v uint32) {
+	binary.LittleEndian.PutUint32(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:4]...)
+}
+
+func (w *encoder) uint64(v uint64) {
+	binary.LittleEndian.PutUint64(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:8]...)
+}
+
+func (w *encoder) uvarint(v uint64) {
+	n := binary.PutUvarint(w.scratch[:], v)
+	w.buf = append(w.buf, w.scratch[:n]...)
+}
+
+func (w *encoder) bytes(v []byte) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+func (w *encoder) string(v string) {
+	w.uvarint(uint64(len(v)))
+	w.buf = append(w.buf, v...)
+}
+
+// decoder reads little endian values from the stream
+type decoder struct {
+	r       *bufio.Reader
+	scratch [8]byte
+}
+
+var errCorrupted = errors.New("snapshot is corrupted")
+
+func (r *decoder) byte() (byte, error) { return r.r.ReadByte() }
+
+func (r *decoder) bool() (bool, error) {
+	b, err := r.byte()
+	return b != 0, err
+}
+
+func (r *decoder) uint16() (uint16, error) {
+	_, err := io.ReadFull(r.r, r.scratch[:2])
+	return binary.LittleEndian.Uint16(r.scratch[:]), err
+}
+
+func (r *decoder) uint32() (uint32, error) {
+	_, err := io.ReadFull(r.r, r.scratch[:4])
+	return binary.LittleEndian.Uint32(r.scratch[:]), err
+}
+
+func (r *decoder) uint64() (uint64, error) {
+	_, err := io.ReadFull(r.r, r.scratch[:8])
+	return binary.LittleEndian.Uint64(r.scratch[:]), err
+}
+
+func (r *decoder) uvarint() (uint64, error) {
+	return binary.ReadUvarint(r.r)
+}
+
+func (r *decoder) bytes() ([]byte, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > math.MaxInt32 {
+		return nil, errCorrupted
+	}
+
+	b := make([]byte, n)
+	_, err = io.ReadFull(r.r, b)
+	return b, err
+}
+
+func (r *decoder) string() (string, error) {
+	b, err := r.bytes()
+	return string(b), err
+}
@@ -0,0 +1,148 @@
+package snapshot
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+const (
+	// Version of the snapshot format written by Export
+	Version = 1
+
+	flagGzip = 1 << 0
+)
+
+var magic = []byte("DFGO")
+
+// ExportOptions contains options for Export function.
+type ExportOptions struct {
+
+	// Compress enables gzip compression of the snapshot. Header of the
+	// snapshot is never compressed.
+	Compress bool
+
+	// CompressionLevel is the gzip compression level. The default value is
+	// gzip.DefaultCompression.
+	CompressionLevel int
+}
+
+// Export writes dataframe in the snapshot format. The snapshot is versioned and
+// self-describing columnar binary format. For each series it stores the name,
+// the type returned by SeriesAny.Type(), the values and null information (nil
+// pointers), so the dataframe can be restored by Load without any converters.
+//
+// Supported are series of numeric types, bool, string, time.Time,
+// decimal.Decimal, pointers to these types and categorical series. Times are
+// stored with their zone offset, location name is not preserved.
+//
+// Layout:
+//
+//	"DFGO" | version (1 byte) | flags (1 byte) | body (gzip compressed if flagged)
+//
+//	body:   nRows, nCols (uvarint) | nCols x (name, type) | nCols x (length (uvarint), values)
+//
+// Example:
+//
+//	f, err := os.Create("data/bars.dfgo")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer f.Close()
+//
+//	err = snapshot.Export(ctx, f, df, snapshot.ExportOptions { Compress: true })
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func Export(ctx context.Context, w io.Writer, df *dataframe.DataFrame, options ...ExportOptions) error {
+	opts := dataframe.DefaultOptions(options...)
+
+	df.RLock(true); defer df.RUnlock(true)
+
+	// Check that all the series can be encoded before anything is written
+	cs := make([]codec, len(df.Series))
+	for i, s := range df.Series {
+		c, ok := codecs[s.Type()]
+		if !ok {
+			return errors.New("unsupported series type: " + s.Type())
+		}
+		cs[i] = c
+	}
+
+	var flags byte
+	if opts.Compress {
+		flags |= flagGzip
+	}
+
+	header := append(append([]byte{}, magic...), Version, flags)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	var body io.Writer = w
+	var zw *gzip.Writer
+
+	if opts.Compress {
+		level := opts.CompressionLevel
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+
+		var err error
+		zw, err = gzip.NewWriterLevel(w, level)
+		if err != nil {
+			return err
+		}
+		body = zw
+	}
+
+	bw := bufio.NewWriter(body)
+
+	enc := &encoder{}
+	enc.uvarint(uint64(df.NRows(dataframe.DontLock)))
+	enc.uvarint(uint64(len(df.Series)))
+	for _, s := range df.Series {
+		enc.string(s.Name(dataframe.DontLock))
+		enc.string(s.Type())
+	}
+
+	if _, err := bw.Write(enc.buf); err != nil {
+		return err
+	}
+
+	for i, s := range df.Series {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		col := &encoder{}
+		if err := cs[i].encode(col, s); err != nil {
+			return err
+		}
+
+		enc.buf = enc.buf[:0]
+		enc.uvarint(uint64(len(col.buf)))
+
+		if _, err := bw.Write(enc.buf); err != nil {
+			return err
+		}
+		if _, err := bw.Write(col.buf); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+
+	if zw != nil {
+		return zw.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// Column describes series stored in the snapshot.
+type Column struct {
+	Name, Type string
+}
+
+// Reader reads snapshot column by column. Columns which are not needed
+// can be skipped without decoding.
+type Reader struct {
+	dec     *decoder
+	zr      *gzip.Reader
+	nRows   int
+	columns []Column
+	next    int
+}
+
+// NewReader reads the header of the snapshot and returns Reader positioned
+// at the first column.
+func NewReader(r io.Reader) (*Reader, error) {
+	header := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(header[:len(magic)], magic) {
+		return nil, errors.New("not a snapshot")
+	}
+
+	if version := header[len(magic)]; version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version: %d", version)
+	}
+
+	sr := &Reader{}
+
+	if header[len(magic)+1]&flagGzip != 0 {
+		zr, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		sr.zr = zr
+		r = zr
+	}
+
+	sr.dec = &decoder{r: bufio.NewReader(r)}
+
+	nRows, err := sr.dec.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	nCols, err := sr.dec.uvarint()
+	if err != nil {
+		return nil, err
+	}
+
+	if nRows > math.MaxInt32 || nCols > math.MaxInt16 {
+		return nil, errCorrupted
+	}
+
+	sr.nRows = int(nRows)
+
+	for i := 0; i < int(nCols); i++ {
+		name, err := sr.dec.string()
+		if err != nil {
+			return nil, err
+		}
+
+		typ, err := sr.dec.string()
+		if err != nil {
+			return nil, err
+		}
+
+		sr.columns = append(sr.columns, Column{name, typ})
+	}
+
+	return sr, nil
+}
+
+// NRows returns the number of rows of the stored dataframe.
+func (sr *Reader) NRows() int {
+	return sr.nRows
+}
+
+// Columns returns all the columns stored in the snapshot.
+func (sr *Reader) Columns() []Column {
+	return sr.columns
+}
+
+// Next reads the next column. It returns io.EOF when there are no
+// more columns.
+func (sr *Reader) Next() (dataframe.SeriesAny, error) {
+	column, length, err := sr.nextColumn()
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := codecs[column.Type]
+	if !ok {
+		return nil, errors.New("unsupported series type: " + column.Type)
+	}
+
+	lr := &decoder{r: bufio.NewReader(io.LimitReader(sr.dec.r, int64(length)))}
+
+	s, err := c.decode(lr, column.Name, sr.nRows)
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errCorrupted
+		}
+		return nil, err
+	}
+
+	// values must consume exactly the whole column
+	if _, err := lr.r.ReadByte(); err != io.EOF {
+		return nil, errCorrupted
+	}
+
+	return s, nil
+}
+
+// Skip skips the next column without decoding its values. It returns io.EOF
+// when there are no more columns.
+func (sr *Reader) Skip() error {
+	_, length, err := sr.nextColumn()
+	if err != nil {
+		return err
+	}
+
+	n, err := io.CopyN(io.Discard, sr.dec.r, int64(length))
+	if err != nil || n != int64(length) {
+		return errCorrupted
+	}
+	return nil
+}
+
+func (sr *Reader) nextColumn() (Column, uint64, error) {
+	if sr.next >= len(sr.columns) {
+		return Column{}, 0, io.EOF
+	}
+
+	column := sr.columns[sr.next]
+	sr.next++
+
+	length, err := sr.dec.uvarint()
+	if err != nil {
+		return column, 0, errCorrupted
+	}
+
+	return column, length, nil
+}
+
+// Close releases resources of the reader. It does not close the underlying reader.
+func (sr *Reader) Close() error {
+	if sr.zr != nil {
+		return sr.zr.Close()
+	}
+	return nil
+}
+
+// LoadOptions contains options for Load function.
+type LoadOptions struct {
+	// Columns defines series to be loaded. All the series are loaded
+	// if Columns is not set.
+	Columns []string
+}
+
+// Function to load dataframe from the snapshot created by Export. Series which are not
+// listed in LoadOptions.Columns are skipped without decoding. Series are loaded in
+// the order in which they are stored.
+//
+// Example:
+//
+//	f, err := os.Open("data/bars.dfgo")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer f.Close()
+//
+//	df, err := snapshot.Load(ctx, f, snapshot.LoadOptions { Columns: []string { "time", "c" } })
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func Load(ctx context.Context, r io.Reader, options ...LoadOptions) (*dataframe.DataFrame, error) {
+	opts := dataframe.DefaultOptions(options...)
+
+	sr, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer sr.Close()
+
+	selected := map[string]bool{}
+	for _, name := range opts.Columns {
+		selected[name] = true
+	}
+
+	for _, name := range opts.Columns {
+		if !containsColumn(sr.Columns(), name) {
+			return nil, errors.New("snapshot does not contain column: " + name)
+		}
+	}
+
+	series := []dataframe.SeriesAny{}
+
+	for _, column := range sr.Columns() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// all the selected columns are loaded, rest of the stream is not needed
+		if len(selected) > 0 && len(series) == len(selected) {
+			break
+		}
+
+		if len(selected) > 0 && !selected[column.Name] {
+			if err := sr.Skip(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		s, err := sr.Next()
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, s)
+	}
+
+	return dataframe.NewDataFrame(series...), nil
+}
+
+func containsColumn(columns []Column, name string) bool {
+	for _, column := range columns {
+		if column.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package sql
+
+import (
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// ConverterFn converts a value returned by the database driver into a
+// value of type T. The driver value is one of the driver.Value types
+// (int64, float64, bool, []byte, string, time.Time) or nil for NULL.
+type ConverterFn[T any] func(any) (T, error)
+
+type ConverterAny interface {
+	// function to instantiatiate series of type T
+	series(string, *dataframe.SeriesInit) dataframe.SeriesAny
+	// function for convert from driver value to any type
+	value(any) (any, error)
+}
+
+// Converter defines custom transformation from driver value to value of
+// type T. To initialize new Converter use sql.NewConverter(ConverterFn[T])
+type Converter[T any] struct {
+	fn ConverterFn[T]
+}
+
+// Function to instantiate new Converter of type T. Converter transforms driver
+// value into value of type T. There are predefined converters like sql.Float64,
+// sql.Int64, sql.NullString, etc.
+//
+// Example:
+//
+//	var Side = NewConverter(
+//		func(v any) (int, error) {
+//			switch string(v.([]byte)) {
+//			case "buy":
+//				return 1, nil
+//			case "sell":
+//				return -1, nil
+//			}
+//			return 0, errors.New("unknown side")
+//		},
+//	)
+func NewConverter[T any](fn ConverterFn[T]) Converter[T] {
+	return Converter[T]{fn}
+}
+
+// Interface ConverterAny function for auto instantiation series of type T
+func (c Converter[T]) series(name string, init *dataframe.SeriesInit) dataframe.SeriesAny {
+	return dataframe.NewSeries[T](name, init)
+}
+
+// Interface ConverterAny function to call converter.fn
+func (c Converter[T]) value(v any) (any, error) {
+	return c.fn(v)
+}
@@ -0,0 +1,192 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// Placeholder defines how parameters are marked in the INSERT statement.
+type Placeholder int
+
+const (
+	// Question marks parameters as ? (MySQL, SQLite)
+	Question Placeholder = iota
+
+	// Dollar marks parameters as $1, $2, ... (PostgreSQL)
+	Dollar
+
+	// Colon marks parameters as :1, :2, ... (Oracle)
+	Colon
+
+	// AtP marks parameters as @p1, @p2, ... (SQL Server)
+	AtP
+)
+
+func (p Placeholder) format(n int) string {
+	switch p {
+	case Dollar:
+		return "$" + strconv.Itoa(n)
+	case Colon:
+		return ":" + strconv.Itoa(n)
+	case AtP:
+		return "@p" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+// Execer is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// ExportOptions contains options for Export function.
+type ExportOptions struct {
+
+	// BatchSize is the number of rows inserted by a single INSERT statement.
+	// The default value is 100.
+	BatchSize int
+
+	// Placeholder sets the parameter style of the driver. The default
+	// value is Question.
+	Placeholder Placeholder
+
+	// Range is used to export a subset of rows from the dataframe.
+	Range dataframe.RangeOptions
+
+	// Columns maps series names to column names of the table. Series
+	// which are not present are written to the column of the same name.
+	Columns map[string]string
+
+	// QuoteIdentifier is used to quote table and column names. Identifiers
+	// are not quoted by default.
+	QuoteIdentifier func(string) string
+}
+
+// Export writes dataframe into the table via batched parameterized INSERT
+// statements. NaN values and nil values are written as NULL. Export does not
+// start a transaction, pass *sql.Tx as db to write all batches atomically.
+//
+// Example:
+//
+//	tx, err := db.BeginTx(ctx, nil)
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	err = sql.Export(ctx, tx, "signals", df, sql.ExportOptions {
+//		Placeholder: sql.Dollar,
+//		BatchSize: 500,
+//	})
+//	if err != nil {
+//		tx.Rollback()
+//		panic(err)
+//	}
+//
+//	err = tx.Commit()
+//
+func Export(ctx context.Context, db Execer, table string, df *dataframe.DataFrame, options ...ExportOptions) error {
+	opts := dataframe.DefaultOptions(options...)
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	quote := opts.QuoteIdentifier
+	if quote == nil {
+		quote = func(s string) string { return s }
+	}
+
+	df.RLock(); defer df.RUnlock()
+
+	if len(df.Series) == 0 {
+		return errors.New("dataframe does not contain any series")
+	}
+
+	nRows := df.NRows(dataframe.DontLock)
+	if nRows == 0 {
+		return nil
+	}
+
+	start, end, err := opts.Range.Limits(nRows)
+	if err != nil {
+		return err
+	}
+
+	columns := make([]string, 0, len(df.Series))
+	for _, name := range df.Names(dataframe.DontLock) {
+		if column, ok := opts.Columns[name]; ok {
+			name = column
+		}
+		columns = append(columns, quote(name))
+	}
+
+	prefix := "INSERT INTO " + quote(table) + " (" + strings.Join(columns, ", ") + ") VALUES "
+
+	for row := start; row <= end; row += opts.BatchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batchEnd := row + opts.BatchSize - 1
+		if batchEnd > end {
+			batchEnd = end
+		}
+
+		query, args := batch(prefix, df, row, batchEnd, opts.Placeholder)
+
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// batch creates INSERT statement and its arguments for rows from start to end
+func batch(prefix string, df *dataframe.DataFrame, start, end int, p Placeholder) (string, []any) {
+	var sb strings.Builder
+
+	args := make([]any, 0, (end-start+1)*len(df.Series))
+
+	sb.WriteString(prefix)
+
+	for row := start; row <= end; row++ {
+		if row > start {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString("(")
+		for col, aSeries := range df.Series {
+			if col > 0 {
+				sb.WriteString(", ")
+			}
+
+			args = append(args, argument(aSeries.ValueAny(row, dataframe.DontLock)))
+			sb.WriteString(p.format(len(args)))
+		}
+		sb.WriteString(")")
+	}
+
+	return sb.String(), args
+}
+
+// argument replaces NaN values by NULL, other values are converted by driver
+func argument(v any) any {
+	switch t := v.(type) {
+	case float64:
+		if math.IsNaN(t) {
+			return nil
+		}
+	case float32:
+		if math.IsNaN(float64(t)) {
+			return nil
+		}
+	}
+	return v
+}
@@ -0,0 +1,209 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+var errNull = errors.New("unexpected NULL value")
+
+// SQL converter for string types. NULL is not allowed.
+var String = NewConverter(
+	func(v any) (string, error) {
+		if v == nil {
+			return "", errNull
+		}
+		return asString(v)
+	},
+)
+
+// SQL converter for nullable string types. NULL is converted to nil.
+var NullString = NewConverter(
+	func(v any) (*string, error) {
+		return nullable(v, asString)
+	},
+)
+
+// SQL converter for float64 types. NULL is converted to NaN.
+var Float64 = NewConverter(
+	func(v any) (float64, error) {
+		if v == nil {
+			return math.NaN(), nil
+		}
+		return asFloat64(v)
+	},
+)
+
+// SQL converter for int64 types. NULL is not allowed.
+var Int64 = NewConverter(
+	func(v any) (int64, error) {
+		if v == nil {
+			return 0, errNull
+		}
+		return asInt64(v)
+	},
+)
+
+// SQL converter for nullable int64 types. NULL is converted to nil.
+var NullInt64 = NewConverter(
+	func(v any) (*int64, error) {
+		return nullable(v, asInt64)
+	},
+)
+
+// SQL converter for bool types. NULL is not allowed.
+var Bool = NewConverter(
+	func(v any) (bool, error) {
+		if v == nil {
+			return false, errNull
+		}
+		return asBool(v)
+	},
+)
+
+// SQL converter for nullable bool types. NULL is converted to nil.
+var NullBool = NewConverter(
+	func(v any) (*bool, error) {
+		return nullable(v, asBool)
+	},
+)
+
+// SQL converter for time.Time types. NULL is not allowed.
+var Time = NewConverter(
+	func(v any) (time.Time, error) {
+		if v == nil {
+			return time.Time{}, errNull
+		}
+		return asTime(v)
+	},
+)
+
+// SQL converter for nullable time.Time types. NULL is converted to nil.
+var NullTime = NewConverter(
+	func(v any) (*time.Time, error) {
+		return nullable(v, asTime)
+	},
+)
+
+// SQL converter for values of unknown type. Values are stored as they are
+// returned by the driver, NULL is stored as nil.
+var Any = NewConverter(
+	func(v any) (any, error) {
+		if b, ok := v.([]byte); ok {
+			// driver may reuse the buffer
+			return string(b), nil
+		}
+		return v, nil
+	},
+)
+
+func nullable[T any](v any, fn func(any) (T, error)) (*T, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	t, err := fn(v)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func asString(v any) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	case time.Time:
+		return t.Format(time.RFC3339Nano), nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+func asFloat64(v any) (float64, error) {
+	switch t := v.(type) {
+	case float64:
+		return t, nil
+	case float32:
+		return float64(t), nil
+	case int64:
+		return float64(t), nil
+	case string:
+		return strconv.ParseFloat(t, 64)
+	case []byte:
+		return strconv.ParseFloat(string(t), 64)
+	}
+	return 0, fmt.Errorf("cannot convert %T to float64", v)
+}
+
+func asInt64(v any) (int64, error) {
+	switch t := v.(type) {
+	case int64:
+		return t, nil
+	case float64:
+		if t != math.Trunc(t) {
+			return 0, fmt.Errorf("cannot convert %v to int64", t)
+		}
+		return int64(t), nil
+	case bool:
+		if t {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		return strconv.ParseInt(t, 10, 64)
+	case []byte:
+		return strconv.ParseInt(string(t), 10, 64)
+	}
+	return 0, fmt.Errorf("cannot convert %T to int64", v)
+}
+
+func asBool(v any) (bool, error) {
+	switch t := v.(type) {
+	case bool:
+		return t, nil
+	case int64:
+		return t != 0, nil
+	case string:
+		return strconv.ParseBool(t)
+	case []byte:
+		return strconv.ParseBool(string(t))
+	}
+	return false, fmt.Errorf("cannot convert %T to bool", v)
+}
+
+// layouts used by drivers which return time values as text
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+func asTime(v any) (time.Time, error) {
+	var s string
+
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case int64:
+		return time.Unix(t, 0), nil
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	default:
+		return time.Time{}, fmt.Errorf("cannot convert %T to time.Time", v)
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot convert %q to time.Time", s)
+}
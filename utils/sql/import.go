@@ -0,0 +1,184 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+type LoadOptions struct {
+	// Converters can be used to override the converter chosen for a column.
+	// Columns which are not present in Converters are converted by the
+	// default converter derived from the column type reported by the driver.
+	Converters map[string]ConverterAny
+
+	// Capacity is used to preallocate series. It is useful when the number
+	// of rows returned by the query is known in advance.
+	Capacity int
+}
+
+// Function to load query results into dataframe. Every column of the result set
+// is stored in series of the same name. The series type is derived from the
+// column type reported by the driver:
+//
+//	integers     -> int64      (*int64 when nullable)
+//	floats       -> float64    (NULL is stored as NaN)
+//	bool         -> bool       (*bool when nullable)
+//	strings      -> string     (*string when nullable)
+//	time.Time    -> time.Time  (*time.Time when nullable)
+//	other        -> any        (NULL is stored as nil)
+//
+// The conversion can be overriden by LoadOptions.Converters. Load does not
+// close rows.
+//
+// Example:
+//
+//	rows, err := db.QueryContext(ctx, "SELECT time, o, h, l, c, v FROM bars WHERE symbol = $1", "EURUSD")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer rows.Close()
+//
+//	df, err := sql.Load(ctx, rows, sql.LoadOptions {
+//		Converters: map[string]sql.ConverterAny { "v": sql.Float64 },
+//	})
+//
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func Load(ctx context.Context, rows *sql.Rows, options ...LoadOptions) (*dataframe.DataFrame, error) {
+	opts := dataframe.DefaultOptions(options...)
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	init := dataframe.SeriesInit{Capacity: opts.Capacity}
+
+	converters := make([]ConverterAny, len(columnTypes))
+	series := make([]dataframe.SeriesAny, len(columnTypes))
+
+	for i, ct := range columnTypes {
+		converter, ok := opts.Converters[ct.Name()]
+		if !ok {
+			converter = defaultConverter(ct)
+		}
+		converters[i] = converter
+		series[i] = converter.series(ct.Name(), &init)
+	}
+
+	df := dataframe.NewDataFrame(series...)
+
+	record := make([]any, len(columnTypes))
+	dest := make([]any, len(columnTypes))
+	for i := range record {
+		dest[i] = &record[i]
+	}
+
+	// lets read result set row by row
+	for rows.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := make([]any, len(columnTypes))
+
+		for i := range record {
+			val, err := converters[i].value(record[i])
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", columnTypes[i].Name(), err)
+			}
+			row[i] = val
+		}
+
+		df.Append(row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return df, nil
+}
+
+var (
+	typeTime        = reflect.TypeOf(time.Time{})
+	typeNullString  = reflect.TypeOf(sql.NullString{})
+	typeNullInt64   = reflect.TypeOf(sql.NullInt64{})
+	typeNullInt32   = reflect.TypeOf(sql.NullInt32{})
+	typeNullInt16   = reflect.TypeOf(sql.NullInt16{})
+	typeNullByte    = reflect.TypeOf(sql.NullByte{})
+	typeNullFloat64 = reflect.TypeOf(sql.NullFloat64{})
+	typeNullBool    = reflect.TypeOf(sql.NullBool{})
+	typeNullTime    = reflect.TypeOf(sql.NullTime{})
+)
+
+// defaultConverter chooses converter by the scan type and nullability
+// reported by the driver
+func defaultConverter(ct *sql.ColumnType) ConverterAny {
+	nullable, ok := ct.Nullable()
+	nullable = nullable && ok
+
+	t := ct.ScanType()
+	if t == nil {
+		return Any
+	}
+
+	switch t {
+	case typeTime:
+		if nullable {
+			return NullTime
+		}
+		return Time
+	case typeNullString:
+		return NullString
+	case typeNullInt64, typeNullInt32, typeNullInt16, typeNullByte:
+		return NullInt64
+	case typeNullFloat64:
+		return Float64
+	case typeNullBool:
+		return NullBool
+	case typeNullTime:
+		return NullTime
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if nullable {
+			return NullInt64
+		}
+		return Int64
+	case reflect.Float32, reflect.Float64:
+		return Float64
+	case reflect.Bool:
+		if nullable {
+			return NullBool
+		}
+		return Bool
+	case reflect.String:
+		if nullable {
+			return NullString
+		}
+		return String
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if nullable {
+				return NullString
+			}
+			return String
+		}
+	}
+
+	return Any
+}
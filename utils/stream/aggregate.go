@@ -0,0 +1,146 @@
+package stream
+
+import (
+	"math"
+	"reflect"
+)
+
+// AggregateFn aggregates values of a field of rows assigned to a window.
+type AggregateFn func(vals []any) any
+
+// First returns the first value, nil if there are no values.
+func First(vals []any) any {
+	if len(vals) == 0 {
+		return nil
+	}
+	return vals[0]
+}
+
+// Last returns the last value, nil if there are no values.
+func Last(vals []any) any {
+	if len(vals) == 0 {
+		return nil
+	}
+	return vals[len(vals) - 1]
+}
+
+// Count returns the number of non-nil values as int.
+func Count(vals []any) any {
+	var n int
+	for _, v := range vals {
+		if v != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Sum returns the sum of numeric values as float64. Nil and NaN values are skipped.
+func Sum(vals []any) any {
+	var sum float64
+	for _, v := range vals {
+		if f, ok := toFloat(v); ok {
+			sum += f
+		}
+	}
+	return sum
+}
+
+// Mean returns the mean of numeric values as float64, NaN if there are no values.
+// Nil and NaN values are skipped.
+func Mean(vals []any) any {
+	var sum float64
+	var n int
+	for _, v := range vals {
+		if f, ok := toFloat(v); ok {
+			sum += f
+			n++
+		}
+	}
+
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / float64(n)
+}
+
+// Min returns the minimum of numeric values as float64, NaN if there are no values.
+// Nil and NaN values are skipped.
+func Min(vals []any) any {
+	return extreme(vals, math.Min)
+}
+
+// Max returns the maximum of numeric values as float64, NaN if there are no values.
+// Nil and NaN values are skipped.
+func Max(vals []any) any {
+	return extreme(vals, math.Max)
+}
+
+func extreme(vals []any, fn func(a, b float64) float64) float64 {
+	res := math.NaN()
+	for _, v := range vals {
+		if f, ok := toFloat(v); ok {
+			if math.IsNaN(res) {
+				res = f
+			} else {
+				res = fn(res, f)
+			}
+		}
+	}
+	return res
+}
+
+// toFloat converts numeric value to float64, it returns false for nil,
+// NaN and non-numeric values
+func toFloat(v any) (float64, bool) {
+	var f float64
+
+	switch n := v.(type) {
+	case float64:
+		f = n
+	case float32:
+		f = float64(n)
+	case int:
+		f = float64(n)
+	case int8:
+		f = float64(n)
+	case int16:
+		f = float64(n)
+	case int32:
+		f = float64(n)
+	case int64:
+		f = float64(n)
+	case uint:
+		f = float64(n)
+	case uint8:
+		f = float64(n)
+	case uint16:
+		f = float64(n)
+	case uint32:
+		f = float64(n)
+	case uint64:
+		f = float64(n)
+	case *float64:
+		if n == nil {
+			return 0, false
+		}
+		f = *n
+	default:
+		return 0, false
+	}
+
+	return f, !math.IsNaN(f)
+}
+
+// resultType returns the type name of results of the aggregation function, it
+// is "" for First, Last and custom functions
+func resultType(fn AggregateFn) string {
+	switch reflect.ValueOf(fn).Pointer() {
+	case reflect.ValueOf(Count).Pointer():
+		return "int"
+	case reflect.ValueOf(Sum).Pointer(), reflect.ValueOf(Mean).Pointer(),
+		reflect.ValueOf(Min).Pointer(), reflect.ValueOf(Max).Pointer():
+		return "float64"
+	}
+	return ""
+}
@@ -0,0 +1,389 @@
+// Package stream implements aggregation of streamed rows into event-time windows.
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// Aggregation defines a column of window rows. Fn aggregates values of Field
+// of all rows assigned to the window.
+type Aggregation struct {
+	Field string
+	Fn    AggregateFn
+}
+
+// WindowOptions is defined as an optional parameters for NewWindower(...) function.
+//
+// Defaults:
+//	• `TimeKey` - "time"
+//	• `StartKey` - TimeKey
+//	• `EndKey` - "", the end of the window is not in window rows
+//	• `Slide` - Size, windows are tumbling
+//	• `AllowedLateness` - 0
+//
+// Properties:
+//	• `TimeKey` - name of the time.Time field of rows
+//	• `StartKey` - name of the column of window rows with the start of the window
+//	• `EndKey` - name of the column of window rows with the end of the window
+//	• `Size` - duration of windows
+//	• `Slide` - duration between starts of windows. If Slide is lower than Size,
+//	   windows are sliding and rows are assigned to multiple windows
+//	• `AllowedLateness` - how much the watermark is behind the latest time seen.
+//	   Windows are finalized when the watermark passes their end, rows of finalized
+//	   windows are dropped
+//	• `Aggregations` - columns of window rows by their names
+type WindowOptions struct {
+	TimeKey, StartKey, EndKey string
+
+	Size, Slide, AllowedLateness time.Duration
+
+	Aggregations map[string]Aggregation
+}
+
+// Windower assigns streamed rows to tumbling or sliding event-time windows
+// and appends finalized windows as aggregated rows into a target DataFrame
+// in the order of their starts. Empty windows are not emitted. Windower can
+// be used by multiple goroutines.
+type Windower struct {
+	target *dataframe.DataFrame
+	opts   WindowOptions
+
+	// distinct fields of aggregations
+	fields []string
+
+	// open windows by start and their sorted starts
+	windows map[int64]*window
+	starts  []int64
+
+	// latest time seen and the end of the last finalized window
+	latest    time.Time
+	finalized time.Time
+
+	dropped int
+
+	mu sync.Mutex
+}
+
+type window struct {
+	start time.Time
+	vals  map[string][]any
+}
+
+// NewWindower creates windower appending window rows into target. Target must
+// contain just series StartKey and EndKey (if set) of type time.Time and a
+// series for every aggregation. Count needs int series, Sum, Mean, Min and Max
+// need float64 series. Results of First, Last and custom aggregations are
+// checked when windows are emitted. An error is returned if options or series
+// of target are not valid.
+//
+// Example:
+//
+//	bars := dataframe.NewDataFrame(
+//		dataframe.NewSeries[time.Time]("time", nil),
+//		dataframe.NewSeries[float64]("open", nil),
+//		dataframe.NewSeries[float64]("high", nil),
+//		dataframe.NewSeries[float64]("low", nil),
+//		dataframe.NewSeries[float64]("close", nil),
+//	)
+//
+//	w, err := stream.NewWindower(bars, stream.WindowOptions {
+//		Size: time.Minute,
+//		AllowedLateness: time.Second,
+//		Aggregations: map[string]stream.Aggregation {
+//			"open":  { "price", stream.First },
+//			"high":  { "price", stream.Max },
+//			"low":   { "price", stream.Min },
+//			"close": { "price", stream.Last },
+//		},
+//	})
+//
+//	err = w.Run(ctx, ticks)
+//
+func NewWindower(target *dataframe.DataFrame, opts WindowOptions) (*Windower, error) {
+	if opts.Size <= 0 {
+		return nil, errors.New("window size must be greater than 0")
+	}
+
+	if opts.Slide == 0 {
+		opts.Slide = opts.Size
+	}
+
+	if opts.Slide < 0 || opts.Slide > opts.Size {
+		return nil, errors.New("window slide must be in range (0, Size]")
+	}
+
+	if opts.AllowedLateness < 0 {
+		return nil, errors.New("allowed lateness must not be negative")
+	}
+
+	if opts.TimeKey == "" {
+		opts.TimeKey = "time"
+	}
+
+	if opts.StartKey == "" {
+		opts.StartKey = opts.TimeKey
+	}
+
+	w := &Windower{
+		target:  target,
+		opts:    opts,
+		windows: map[int64]*window{},
+	}
+
+	seen := map[string]bool{}
+	for _, agg := range opts.Aggregations {
+		if agg.Fn == nil {
+			return nil, errors.New("aggregation function must not be nil")
+		}
+
+		if !seen[agg.Field] {
+			seen[agg.Field] = true
+			w.fields = append(w.fields, agg.Field)
+		}
+	}
+
+	if err := w.validate(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// validate checks that the target has just the series of window rows and
+// that their types match the aggregations
+func (w *Windower) validate() error {
+	types := map[string]string{}
+	for name, agg := range w.opts.Aggregations {
+		types[name] = resultType(agg.Fn)
+	}
+
+	for _, key := range []string{w.opts.StartKey, w.opts.EndKey} {
+		if key == "" {
+			continue
+		}
+		if _, ok := types[key]; ok {
+			return errors.New("names of window columns must be unique: " + key)
+		}
+		types[key] = dataframe.TypeName[time.Time]()
+	}
+
+	w.target.RLock(); defer w.target.RUnlock()
+
+	if n := len(w.target.Series); n != len(types) {
+		return fmt.Errorf("target has %d series, window rows have %d columns", n, len(types))
+	}
+
+	for _, s := range w.target.Series {
+		name := s.Name()
+
+		typ, ok := types[name]
+		if !ok {
+			return errors.New("target series is not a window column: " + name)
+		}
+
+		if typ != "" && s.Type() != typ {
+			return fmt.Errorf("target series %s has type %s, want %s", name, s.Type(), typ)
+		}
+	}
+
+	return nil
+}
+
+// Add assigns the row to its windows and appends windows finalized by the
+// new watermark into the target. Rows of finalized windows are dropped. An
+// error is returned if the row has no time or if aggregated values of a
+// finalized window don't fit into the target, such window is dropped.
+func (w *Windower) Add(row map[string]any) error {
+	t, ok := row[w.opts.TimeKey].(time.Time)
+	if !ok {
+		return errors.New("row has no time.Time field: " + w.opts.TimeKey)
+	}
+
+	w.mu.Lock(); defer w.mu.Unlock()
+
+	if t.After(w.latest) {
+		w.latest = t
+	}
+
+	dropped := true
+
+	// starts of windows containing t
+	first := t.Truncate(w.opts.Slide)
+	for start := first; start.Add(w.opts.Size).After(t); start = start.Add(-w.opts.Slide) {
+		if !start.Add(w.opts.Size).After(w.finalized) {
+			continue
+		}
+
+		w.window(start).add(row, w.fields)
+		dropped = false
+	}
+
+	if dropped {
+		w.dropped++
+	}
+
+	return w.emit(w.latest.Add(-w.opts.AllowedLateness))
+}
+
+// Run adds rows received from the channel until it is closed or ctx is canceled.
+// All open windows are emitted by Flush when the channel is closed.
+func (w *Windower) Run(ctx context.Context, rows <-chan map[string]any) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case row, ok := <-rows:
+			if !ok {
+				return w.Flush()
+			}
+
+			if err := w.Add(row); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Flush appends all open windows into the target. Like Add, it returns an
+// error if aggregated values of a window don't fit into the target.
+func (w *Windower) Flush() error {
+	w.mu.Lock(); defer w.mu.Unlock()
+
+	if len(w.starts) > 0 {
+		last := w.windows[w.starts[len(w.starts) - 1]]
+		return w.emit(last.start.Add(w.opts.Size))
+	}
+
+	return nil
+}
+
+// Watermark returns the time which windows ending before or at are finalized.
+func (w *Windower) Watermark() time.Time {
+	w.mu.Lock(); defer w.mu.Unlock()
+	return w.latest.Add(-w.opts.AllowedLateness)
+}
+
+// Dropped returns the number of rows dropped because they were late.
+func (w *Windower) Dropped() int {
+	w.mu.Lock(); defer w.mu.Unlock()
+	return w.dropped
+}
+
+// window returns the open window of start
+func (w *Windower) window(start time.Time) *window {
+	key := start.UnixNano()
+
+	if win, ok := w.windows[key]; ok {
+		return win
+	}
+
+	win := &window{start: start, vals: map[string][]any{}}
+	w.windows[key] = win
+
+	i := sort.Search(len(w.starts), func(i int) bool { return w.starts[i] >= key })
+	w.starts = append(w.starts, 0)
+	copy(w.starts[i+1:], w.starts[i:])
+	w.starts[i] = key
+
+	return win
+}
+
+// emit appends windows ending before or at watermark into the target. A window
+// with values which don't fit into the series of the target is dropped and
+// an error is returned.
+func (w *Windower) emit(watermark time.Time) error {
+	for len(w.starts) > 0 {
+		win := w.windows[w.starts[0]]
+
+		end := win.start.Add(w.opts.Size)
+		if end.After(watermark) {
+			return nil
+		}
+
+		delete(w.windows, w.starts[0])
+		w.starts = w.starts[1:]
+
+		if end.After(w.finalized) {
+			w.finalized = end
+		}
+
+		row := win.row(w.opts)
+		if err := w.check(row); err != nil {
+			return err
+		}
+
+		w.target.Append(row)
+	}
+
+	return nil
+}
+
+// check returns an error if values of the row don't fit into the series of
+// the target, so Append of the row does not panic
+func (w *Windower) check(row map[string]any) error {
+	w.target.RLock(); defer w.target.RUnlock()
+
+	if len(w.target.Series) != len(row) {
+		return fmt.Errorf("target has %d series, window rows have %d columns", len(w.target.Series), len(row))
+	}
+
+	for name, v := range row {
+		col, err := w.target.NameToColumn(name, dataframe.DontLock)
+		if err != nil {
+			return errors.New(err.Error() + ": " + name)
+		}
+
+		if typ := w.target.Series[col].Type(); !fits(typ, v) {
+			return fmt.Errorf("value %v of window column %s does not fit into series of type %s", v, name, typ)
+		}
+	}
+
+	return nil
+}
+
+// fits returns true if v can be appended to series of type typ
+func fits(typ string, v any) bool {
+	switch {
+	case typ == "any":
+		return true
+	case typ == "categorical":
+		_, ok := v.(string)
+		return ok || v == nil
+	case v == nil:
+		return strings.HasPrefix(typ, "*")
+	}
+	return fmt.Sprintf("%T", v) == typ
+}
+
+func (win *window) add(row map[string]any, fields []string) {
+	for _, field := range fields {
+		if v, ok := row[field]; ok {
+			win.vals[field] = append(win.vals[field], v)
+		}
+	}
+}
+
+// row returns the aggregated row of the window
+func (win *window) row(opts WindowOptions) map[string]any {
+	row := map[string]any{
+		opts.StartKey: win.start,
+	}
+
+	if opts.EndKey != "" {
+		row[opts.EndKey] = win.start.Add(opts.Size)
+	}
+
+	for name, agg := range opts.Aggregations {
+		row[name] = agg.Fn(win.vals[agg.Field])
+	}
+
+	return row
+}
@@ -0,0 +1,288 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+// Sheet defines worksheet created from dataframe by Export function.
+type Sheet struct {
+	// Name of the worksheet. It must be unique and it must not be longer
+	// than 31 characters.
+	Name string
+
+	// DataFrame to be written to the worksheet.
+	DataFrame *dataframe.DataFrame
+
+	// Range is used to export a subset of rows from the dataframe.
+	Range dataframe.RangeOptions
+}
+
+// Export creates XLSX workbook containing one worksheet for each of sheets.
+// The first row of every worksheet contains series names. Numeric values are
+// written as numbers, time.Time values as date cells and other values as
+// strings defined by the series formatter. NaN and nil values are written as
+// empty cells.
+//
+// Example:
+//
+//	f, err := os.Create("data/risk.xlsx")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer f.Close()
+//
+//	err = xlsx.Export(ctx, f,
+//		xlsx.Sheet { Name: "positions", DataFrame: positions },
+//		xlsx.Sheet { Name: "pnl", DataFrame: pnl },
+//	)
+//
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func Export(ctx context.Context, w io.Writer, sheets ...Sheet) error {
+	if len(sheets) == 0 {
+		return errors.New("at least one sheet is required")
+	}
+
+	names := map[string]bool{}
+	for _, sheet := range sheets {
+		if err := validateSheetName(sheet.Name); err != nil {
+			return err
+		}
+		if names[strings.ToLower(sheet.Name)] {
+			return errors.New("names of sheets must be unique: " + sheet.Name)
+		}
+		names[strings.ToLower(sheet.Name)] = true
+	}
+
+	zw := zip.NewWriter(w)
+
+	if err := writeFile(zw, "[Content_Types].xml", contentTypes(len(sheets))); err != nil {
+		return err
+	}
+
+	if err := writeFile(zw, "_rels/.rels", rootRels); err != nil {
+		return err
+	}
+
+	if err := writeFile(zw, "xl/workbook.xml", workbookXML(sheets)); err != nil {
+		return err
+	}
+
+	if err := writeFile(zw, "xl/_rels/workbook.xml.rels", workbookRels(len(sheets))); err != nil {
+		return err
+	}
+
+	if err := writeFile(zw, "xl/styles.xml", stylesXML); err != nil {
+		return err
+	}
+
+	for i, sheet := range sheets {
+		fw, err := zw.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1))
+		if err != nil {
+			return err
+		}
+
+		if err := writeSheet(ctx, fw, sheet); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeSheet(ctx context.Context, w io.Writer, sheet Sheet) error {
+	df := sheet.DataFrame
+
+	df.RLock(); defer df.RUnlock()
+
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(xml.Header)
+	bw.WriteString(`<worksheet xmlns="` + xmlnsMain + `"><sheetData>`)
+
+	// Write header -> series names
+	bw.WriteString(`<row r="1">`)
+	for col, name := range df.Names(dataframe.DontLock) {
+		writeStringCell(bw, col, 1, name)
+	}
+	bw.WriteString(`</row>`)
+
+	nRows := df.NRows(dataframe.DontLock)
+
+	if nRows > 0 {
+		start, end, err := sheet.Range.Limits(nRows)
+		if err != nil {
+			return err
+		}
+
+		for row := start; row <= end; row++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			r := row - start + 2
+
+			fmt.Fprintf(bw, `<row r="%d">`, r)
+			for col, aSeries := range df.Series {
+				writeCell(bw, col, r, aSeries, row)
+			}
+			bw.WriteString(`</row>`)
+		}
+	}
+
+	bw.WriteString(`</sheetData></worksheet>`)
+
+	return bw.Flush()
+}
+
+func writeCell(w *bufio.Writer, col, r int, s dataframe.SeriesAny, row int) {
+	val := s.ValueAny(row, dataframe.DontLock)
+	if val == nil {
+		return
+	}
+
+	rv := reflect.ValueOf(val)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return
+		}
+		val = rv.Elem().Interface()
+		rv = rv.Elem()
+	}
+
+	ref := columnName(col) + strconv.Itoa(r)
+
+	switch v := val.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return
+		}
+		fmt.Fprintf(w, `<c r="%s" s="1"><v>%s</v></c>`, ref, strconv.FormatFloat(timeToSerial(v), 'f', -1, 64))
+		return
+	case bool:
+		b := "0"
+		if v {
+			b = "1"
+		}
+		fmt.Fprintf(w, `<c r="%s" t="b"><v>%s</v></c>`, ref, b)
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return
+		}
+		fmt.Fprintf(w, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(f, 'g', -1, 64))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		fmt.Fprintf(w, `<c r="%s"><v>%d</v></c>`, ref, rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		fmt.Fprintf(w, `<c r="%s"><v>%d</v></c>`, ref, rv.Uint())
+	default:
+		writeStringCell(w, col, r, s.ValueString(row, dataframe.DontLock))
+	}
+}
+
+func writeStringCell(w *bufio.Writer, col, r int, s string) {
+	fmt.Fprintf(w, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, columnName(col), r)
+	xml.EscapeText(w, []byte(s))
+	w.WriteString(`</t></is></c>`)
+}
+
+func writeFile(zw *zip.Writer, name, content string) error {
+	fw, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(fw, content)
+	return err
+}
+
+func validateSheetName(name string) error {
+	if name == "" || len([]rune(name)) > 31 {
+		return errors.New("sheet name must contain 1 to 31 characters: " + name)
+	}
+	if strings.ContainsAny(name, `[]:*?/\`) {
+		return errors.New("sheet name contains invalid character: " + name)
+	}
+	return nil
+}
+
+func contentTypes(nSheets int) string {
+	var sb strings.Builder
+
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	sb.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	sb.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	sb.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= nSheets; i++ {
+		fmt.Fprintf(&sb, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	sb.WriteString(`</Types>`)
+
+	return sb.String()
+}
+
+const rootRels = xml.Header +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func workbookXML(sheets []Sheet) string {
+	var sb strings.Builder
+
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<workbook xmlns="` + xmlnsMain + `" xmlns:r="` + xmlnsRelationships + `"><sheets>`)
+	for i, sheet := range sheets {
+		sb.WriteString(`<sheet name="`)
+		xml.EscapeText(&sb, []byte(sheet.Name))
+		fmt.Fprintf(&sb, `" sheetId="%d" r:id="rId%d"/>`, i+1, i+1)
+	}
+	sb.WriteString(`</sheets></workbook>`)
+
+	return sb.String()
+}
+
+func workbookRels(nSheets int) string {
+	var sb strings.Builder
+
+	sb.WriteString(xml.Header)
+	sb.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= nSheets; i++ {
+		fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&sb, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, nSheets+1)
+	sb.WriteString(`</Relationships>`)
+
+	return sb.String()
+}
+
+// stylesXML defines two cell formats: 0 - General, 1 - date and time
+const stylesXML = xml.Header +
+	`<styleSheet xmlns="` + xmlnsMain + `">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd hh:mm:ss"/></numFmts>` +
+	`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+	`</styleSheet>`
@@ -0,0 +1,133 @@
+package xlsx
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// XLSX converter for string types
+var String = NewConverter(
+	func(s string) string {
+		return s
+	},
+)
+
+// XLSX converter for float64 types. Empty cells are converted to NaN.
+var Float64 = NewConverter(
+	func(s string) float64 {
+		if s == "" {
+			return math.NaN()
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			panic(err)
+		}
+		return v
+	},
+)
+
+// XLSX converter for float32 types. Empty cells are converted to NaN.
+var Float32 = NewConverter(
+	func(s string) float32 {
+		if s == "" {
+			return float32(math.NaN())
+		}
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			panic(err)
+		}
+		return float32(v)
+	},
+)
+
+// XLSX converter for int64 types
+var Int64 = NewConverter(
+	func(s string) int64 {
+		return int64(parseInt(s, 64))
+	},
+)
+
+// XLSX converter for int32 types
+var Int32 = NewConverter(
+	func(s string) int32 {
+		return int32(parseInt(s, 32))
+	},
+)
+
+// XLSX converter for int types
+var Int = NewConverter(
+	func(s string) int {
+		return int(parseInt(s, 0))
+	},
+)
+
+// XLSX converter for bool types
+var Bool = NewConverter(
+	func(s string) bool {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			panic(err)
+		}
+		return v
+	},
+)
+
+// XLSX converter for time.Time types. Cells formatted as date are converted
+// to RFC3339 before they are passed to converter. Serial numbers of the
+// 1900 date system are also accepted.
+var Time = NewConverter(
+	func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			serial, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				panic(err)
+			}
+			return serialToTime(serial, false)
+		}
+		return t
+	},
+)
+
+// parseInt parses integer which can be stored by Excel in float format
+// like 1E+3
+func parseInt(s string, bitSize int) int64 {
+	v, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return v
+	}
+
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) {
+		panic(err)
+	}
+	return int64(f)
+}
+
+var (
+	epoch1900 = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	epoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// serialToTime converts Excel serial date number to time. Serial numbers
+// are rounded to milliseconds.
+func serialToTime(serial float64, date1904 bool) time.Time {
+	epoch := epoch1900
+	if date1904 {
+		epoch = epoch1904
+	}
+
+	days := math.Floor(serial)
+	ms := math.Round((serial - days) * 24 * 60 * 60 * 1000)
+
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(ms) * time.Millisecond)
+}
+
+// timeToSerial converts time to Excel serial date number of the 1900 date
+// system. The wall clock of the time location is used.
+func timeToSerial(t time.Time) float64 {
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	seconds := float64(wall.Unix()-epoch1900.Unix()) + float64(wall.Nanosecond())/1e9
+	return seconds / (24 * 60 * 60)
+}
@@ -0,0 +1,398 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tradeoforigin/dataframe-go"
+)
+
+type LoadOptions struct {
+	// Sheet is the name of the worksheet to load. The first worksheet
+	// of the workbook is loaded if Sheet is not set.
+	Sheet string
+
+	// Headers must be set if the worksheet does not contain a header row. This must be nil if the worksheet contains a
+	// header row.
+	Headers []string
+}
+
+// Function to load XLSX worksheet into dataframe. XLSX loader is defined by io.ReaderAt, size of
+// the file and converters for specific series. Series defined in converters will be under the same
+// name in resulted dataframe. If LoadOptions headers field is not set, the worksheet must contain
+// header row at the first place, otherwise error is returned.
+//
+// Cell values are passed to converters as strings. Cells formatted as date or time are passed in
+// RFC3339 format, boolean cells as "true" or "false" and empty cells as empty string.
+//
+// Example:
+//
+//	f, err := os.Open("data/bars.xlsx")
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer f.Close()
+//
+//	info, err := f.Stat()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	df, err := xlsx.Load(ctx, f, info.Size(), map[string]xlsx.ConverterAny {
+//		"time": xlsx.Time, "o": xlsx.Float64, "h": xlsx.Float64, "l": xlsx.Float64, "c": xlsx.Float64,
+//	}, xlsx.LoadOptions { Sheet: "EURUSD" })
+//
+//	if err != nil {
+//		panic(err)
+//	}
+//
+func Load(ctx context.Context, r io.ReaderAt, size int64, converters map[string]ConverterAny, options ...LoadOptions) (*dataframe.DataFrame, error) {
+	opts := dataframe.DefaultOptions(options...)
+
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	wb, err := openWorkbook(files)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetPath, err := wb.sheetPath(files, opts.Sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := files[sheetPath]
+	if !ok {
+		return nil, errors.New("worksheet not found: " + sheetPath)
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	records, err := readRows(ctx, rc, wb)
+	if err != nil {
+		return nil, err
+	}
+
+	// if headers field is not set, we need to read first
+	// row as header row
+	if len(opts.Headers) == 0 {
+		if len(records) == 0 {
+			return nil, errors.New("worksheet does not contain header row")
+		}
+		opts.Headers = records[0]
+		records = records[1:]
+	}
+
+	init := dataframe.SeriesInit{Capacity: len(records)}
+
+	series := make([]dataframe.SeriesAny, 0, len(converters))
+	seriesIdx := map[string]int{}
+
+	// Init series and map series name to column index
+	for name, converter := range converters {
+		series = append(series, converter.series(name, &init))
+
+		for i := range opts.Headers {
+			if name == opts.Headers[i] {
+				seriesIdx[name] = i
+				break
+			}
+		}
+	}
+
+	if len(seriesIdx) != len(series) {
+		return nil, errors.New("could not map columns to series")
+	}
+
+	df := dataframe.NewDataFrame(series...)
+
+	for _, record := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		row := map[string]any{}
+
+		for name, idx := range seriesIdx {
+			var cell string
+			if idx < len(record) {
+				cell = record[idx]
+			}
+			row[name] = converters[name].value(cell)
+		}
+
+		df.Append(row)
+	}
+
+	return df, nil
+}
+
+// workbook contains parts of the workbook needed to read cell values
+type workbook struct {
+	xlsxWorkbook
+	rels          map[string]string
+	sharedStrings []string
+	dateStyles    map[int]bool
+}
+
+func openWorkbook(files map[string]*zip.File) (*workbook, error) {
+	wb := &workbook{rels: map[string]string{}, dateStyles: map[int]bool{}}
+
+	if err := decodeFile(files, "xl/workbook.xml", &wb.xlsxWorkbook, true); err != nil {
+		return nil, err
+	}
+
+	var rels xlsxRelationships
+	if err := decodeFile(files, "xl/_rels/workbook.xml.rels", &rels, true); err != nil {
+		return nil, err
+	}
+
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			wb.rels[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			wb.rels[rel.ID] = path.Join("xl", rel.Target)
+		}
+	}
+
+	var sst xlsxSharedStrings
+	if err := decodeFile(files, "xl/sharedStrings.xml", &sst, false); err != nil {
+		return nil, err
+	}
+
+	for _, si := range sst.Items {
+		wb.sharedStrings = append(wb.sharedStrings, si.String())
+	}
+
+	var styles xlsxStyleSheet
+	if err := decodeFile(files, "xl/styles.xml", &styles, false); err != nil {
+		return nil, err
+	}
+
+	customFmts := map[int]string{}
+	for _, numFmt := range styles.NumFmts {
+		customFmts[numFmt.ID] = numFmt.Code
+	}
+
+	for idx, xf := range styles.CellXfs {
+		if code, ok := customFmts[xf.NumFmtID]; ok {
+			wb.dateStyles[idx] = isDateFormat(code)
+		} else {
+			wb.dateStyles[idx] = isDateFormatID(xf.NumFmtID)
+		}
+	}
+
+	return wb, nil
+}
+
+// sheetPath returns path of the worksheet in the archive
+func (wb *workbook) sheetPath(files map[string]*zip.File, name string) (string, error) {
+	for _, sheet := range wb.Sheets {
+		if name == "" || sheet.Name == name {
+			target, ok := wb.rels[sheet.RID]
+			if !ok {
+				return "", errors.New("missing relationship for worksheet: " + sheet.Name)
+			}
+			return target, nil
+		}
+	}
+
+	if name == "" {
+		return "", errors.New("workbook does not contain any worksheet")
+	}
+	return "", errors.New("no worksheet contains name: " + name)
+}
+
+// cellValue returns string representation of the cell
+func (wb *workbook) cellValue(t, v string, is xlsxRichText, style int) (string, error) {
+	switch t {
+	case "s":
+		idx, err := strconv.Atoi(v)
+		if err != nil {
+			return "", err
+		}
+		if idx < 0 || idx >= len(wb.sharedStrings) {
+			return "", errors.New("invalid shared string index: " + v)
+		}
+		return wb.sharedStrings[idx], nil
+	case "inlineStr":
+		return is.String(), nil
+	case "b":
+		return strconv.FormatBool(v == "1"), nil
+	case "", "n":
+		if v != "" && wb.dateStyles[style] {
+			serial, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return "", err
+			}
+			return serialToTime(serial, wb.WorkbookPr.Date1904).Format(time.RFC3339Nano), nil
+		}
+	}
+
+	// str, e, d
+	return v, nil
+}
+
+// readRows reads all the rows of the worksheet. Missing cells are
+// returned as empty strings.
+func readRows(ctx context.Context, r io.Reader, wb *workbook) ([][]string, error) {
+	records := [][]string{}
+
+	dec := xml.NewDecoder(r)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		token, err := dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row xlsxRow
+		if err := dec.DecodeElement(&row, &start); err != nil {
+			return nil, err
+		}
+
+		rowIdx := len(records)
+		if row.R > 0 {
+			rowIdx = row.R - 1
+		}
+
+		// fill missing rows
+		for len(records) <= rowIdx {
+			records = append(records, []string{})
+		}
+
+		record := []string{}
+
+		for _, c := range row.Cells {
+			col := len(record)
+			if c.R != "" {
+				col, err = columnIndex(c.R)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for len(record) <= col {
+				record = append(record, "")
+			}
+
+			record[col], err = wb.cellValue(c.T, c.V, c.IS, c.S)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		records[rowIdx] = record
+	}
+
+	return records, nil
+}
+
+// decodeFile decodes XML file from the archive. If required is false, missing
+// file is not an error.
+func decodeFile(files map[string]*zip.File, name string, v any, required bool) error {
+	f, ok := files[name]
+	if !ok {
+		if required {
+			return errors.New("missing file in archive: " + name)
+		}
+		return nil
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return xml.NewDecoder(rc).Decode(v)
+}
+
+// columnIndex converts cell reference like "AB12" to zero based column index
+func columnIndex(ref string) (int, error) {
+	col := 0
+	for i, c := range ref {
+		if c >= 'A' && c <= 'Z' {
+			col = col*26 + int(c-'A') + 1
+			continue
+		}
+		if i == 0 {
+			break
+		}
+		return col - 1, nil
+	}
+	return 0, errors.New("invalid cell reference: " + ref)
+}
+
+// columnName converts zero based column index to column name like "AB"
+func columnName(col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name
+}
+
+// isDateFormatID returns true for built-in number formats which display
+// date or time
+func isDateFormatID(id int) bool {
+	return (id >= 14 && id <= 22) || (id >= 27 && id <= 36) || (id >= 45 && id <= 47) || (id >= 50 && id <= 58)
+}
+
+// isDateFormat returns true if custom number format displays date or time.
+// Quoted text, escaped characters and sections in brackets like colors
+// are ignored.
+func isDateFormat(code string) bool {
+	var quoted, bracket, escaped bool
+
+	for _, c := range strings.ToLower(code) {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[':
+			bracket = true
+		case c == ']':
+			bracket = false
+		case bracket:
+		case c == 'y', c == 'm', c == 'd', c == 'h', c == 's':
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package xlsx
+
+import "encoding/xml"
+
+// Minimal subset of SpreadsheetML (ECMA-376) needed to read and write
+// worksheets.
+
+type xlsxWorkbook struct {
+	WorkbookPr struct {
+		Date1904 bool `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+type xlsxRichText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (rt xlsxRichText) String() string {
+	if len(rt.Runs) == 0 {
+		return rt.T
+	}
+
+	s := rt.T
+	for _, r := range rt.Runs {
+		s += r.T
+	}
+	return s
+}
+
+type xlsxStyleSheet struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+type xlsxRow struct {
+	XMLName xml.Name `xml:"row"`
+	R       int      `xml:"r,attr"`
+	Cells   []struct {
+		R string        `xml:"r,attr"`
+		S int           `xml:"s,attr"`
+		T string        `xml:"t,attr"`
+		V string        `xml:"v"`
+		IS xlsxRichText `xml:"is"`
+	} `xml:"c"`
+}
+
+const (
+	xmlnsMain          = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	xmlnsRelationships = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+)
@@ -0,0 +1,352 @@
+package dataframe
+
+import (
+	"context"
+)
+
+// seriesView implements SeriesReader of a window of rows of the parent series.
+// The window shares values with the parent. Fields of the window never change,
+// so it is read without locking.
+type seriesView struct {
+	parent, window SeriesAny
+}
+
+// Name returns the name of the series.
+func (v *seriesView) Name(options ...Options) string {
+	return v.window.Name(dontLock)
+}
+
+// Type returns the type of values of the series.
+func (v *seriesView) Type() string {
+	return v.window.Type()
+}
+
+// NRows returns how many rows the view contains.
+func (v *seriesView) NRows(options ...Options) int {
+	return v.window.NRows(dontLock)
+}
+
+// ValueAny returns the value of a particular row of the view.
+func (v *seriesView) ValueAny(row int, options ...Options) any {
+	return v.window.ValueAny(row, dontLock)
+}
+
+// ValueString returns a string representation of a particular row of the view.
+func (v *seriesView) ValueString(row int, options ...Options) string {
+	return v.window.ValueString(row, dontLock)
+}
+
+// IteratorAny will return a iterator that can be used to iterate through all the values.
+func (v *seriesView) IteratorAny(options ...IteratorOptions) Iterator[any] {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return v.window.IteratorAny(opts)
+}
+
+// IsEqualAnyFunc returns true if a is equal to b.
+func (v *seriesView) IsEqualAnyFunc(a, b any) bool {
+	return v.window.IsEqualAnyFunc(a, b)
+}
+
+// IsLessThanAnyFunc returns true if a is less than b.
+func (v *seriesView) IsLessThanAnyFunc(a, b any) bool {
+	return v.window.IsLessThanAnyFunc(a, b)
+}
+
+// CopyAny materializes the view into a new series.
+func (v *seriesView) CopyAny(options ...RangeOptions) SeriesAny {
+	return materialize(v.window, options...)
+}
+
+// Table will produce the view in a table.
+func (v *seriesView) Table(options ...TableOptions) string {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return v.window.Table(opts)
+}
+
+// String implements the fmt.Stringer interface.
+func (v *seriesView) String() string {
+	return v.window.String()
+}
+
+// IsEqualAny returns true if s2's values are equal to values of the view.
+func (v *seriesView) IsEqualAny(ctx context.Context, s2 SeriesAny, options ...IsEqualOptions) (bool, error) {
+	return v.window.IsEqualAny(ctx, s2, options...)
+}
+
+// RLock read-locks the parent series.
+func (v *seriesView) RLock() {
+	v.parent.RLock()
+}
+
+// RUnlock read-unlocks the parent series.
+func (v *seriesView) RUnlock() {
+	v.parent.RUnlock()
+}
+
+// SeriesView is a read-only view of a range of rows of a series. It shares
+// values with the series, so it is created in O(1) without copying. Updates
+// of the series are visible in the view until the series reallocates its
+// values (e.g. by Append beyond capacity). Views do not lock the series,
+// use RLock if the series is changed concurrently. The view can be
+// materialized by Copy.
+type SeriesView[T any] struct {
+	seriesView
+
+	// Values shares the values of the series.
+	//
+	// WARNING: Do not modify directly.
+	Values []T
+
+	window *Series[T]
+}
+
+// View returns a read-only view of the range of rows.
+//
+// Example:
+//
+//	last500 := s.View(Range(-500))
+//
+func (s *Series[T]) View(options ...RangeOptions) *SeriesView[T] {
+	s.RLock(); defer s.RUnlock()
+
+	start, end := viewLimits(len(s.Values), DefaultOptions(options...))
+	return s.newView(start, end)
+}
+
+// Head returns a read-only view of the first n rows.
+func (s *Series[T]) Head(n int, options ...Options) *SeriesView[T] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	return s.newView(0, headEnd(len(s.Values), n))
+}
+
+// Tail returns a read-only view of the last n rows.
+func (s *Series[T]) Tail(n int, options ...Options) *SeriesView[T] {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		s.RLock(); defer s.RUnlock()
+	}
+
+	return s.newView(len(s.Values) - headEnd(len(s.Values), n), len(s.Values))
+}
+
+func (s *Series[T]) newView(start, end int) *SeriesView[T] {
+	w := s.window(start, end).(*Series[T])
+
+	return &SeriesView[T]{
+		seriesView: seriesView{parent: s, window: w},
+		Values:     w.Values,
+		window:     w,
+	}
+}
+
+// window creates series of values in rows [start, end) shared with s.
+// Capacity of the values is limited, so appending to the window can not
+// overwrite values of s.
+func (s *Series[T]) window(start, end int) SeriesAny {
+	return &Series[T]{
+		valFormatter:   s.valFormatter,
+		isEqualFunc:    s.isEqualFunc,
+		isLessThanFunc: s.isLessThanFunc,
+		name:           s.name,
+		typeT:          s.typeT,
+		Values:         s.Values[start:end:end],
+	}
+}
+
+// Value returns the value of a particular row of the view. Negative rows
+// are indexed from the end.
+func (v *SeriesView[T]) Value(row int) T {
+	return v.window.Value(row, dontLock)
+}
+
+// Iterator will return a iterator that can be used to iterate through all the values.
+func (v *SeriesView[T]) Iterator(options ...IteratorOptions) Iterator[T] {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return v.window.Iterator(opts)
+}
+
+// Copy materializes the view (or its range) into a new series.
+func (v *SeriesView[T]) Copy(options ...RangeOptions) *Series[T] {
+	return materialize(v.window, options...).(*Series[T])
+}
+
+// View returns a read-only view of the range of rows of the view.
+func (v *SeriesView[T]) View(options ...RangeOptions) *SeriesView[T] {
+	start, end := viewLimits(len(v.Values), DefaultOptions(options...))
+
+	nv := v.window.newView(start, end)
+	nv.parent = v.parent
+	return nv
+}
+
+// DataFrameView is a read-only view of a range of rows of a DataFrame. Series
+// of the view share values with series of the DataFrame, see SeriesView.
+type DataFrameView struct {
+	Series []SeriesReader
+
+	window *DataFrame
+}
+
+// View returns a read-only view of the range of rows.
+//
+// Example:
+//
+//	chart := df.View(Range(-500))
+//	fmt.Println(chart.Table())
+//
+func (df *DataFrame) View(options ...RangeOptions) *DataFrameView {
+	df.lock.RLock(); defer df.lock.RUnlock()
+
+	start, end := viewLimits(df.n, DefaultOptions(options...))
+	return df.newView(start, end)
+}
+
+// Head returns a read-only view of the first n rows.
+func (df *DataFrame) Head(n int, options ...Options) *DataFrameView {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock(); defer df.lock.RUnlock()
+	}
+
+	return df.newView(0, headEnd(df.n, n))
+}
+
+// Tail returns a read-only view of the last n rows.
+func (df *DataFrame) Tail(n int, options ...Options) *DataFrameView {
+	opts := DefaultOptions(options...)
+
+	if !opts.DontLock {
+		df.lock.RLock(); defer df.lock.RUnlock()
+	}
+
+	return df.newView(df.n - headEnd(df.n, n), df.n)
+}
+
+func (df *DataFrame) newView(start, end int) *DataFrameView {
+	v := &DataFrameView{
+		window: &DataFrame{n: end - start},
+	}
+
+	for _, s := range df.Series {
+		s.RLock()
+		w := s.window(start, end)
+		s.RUnlock()
+
+		v.window.Series = append(v.window.Series, w)
+		v.Series = append(v.Series, &seriesView{parent: s, window: w})
+	}
+
+	return v
+}
+
+// NRows returns the number of rows of the view.
+func (v *DataFrameView) NRows() int {
+	return v.window.n
+}
+
+// Names will return a list of all the series names.
+func (v *DataFrameView) Names() []string {
+	names := make([]string, 0, len(v.Series))
+	for _, s := range v.Series {
+		names = append(names, s.Name())
+	}
+	return names
+}
+
+// Row returns the series' values for a particular row of the view.
+func (v *DataFrameView) Row(row int) map[string]any {
+	return v.window.Row(row, dontLock)
+}
+
+// Iterator will return a iterator that can be used to iterate through all the rows.
+func (v *DataFrameView) Iterator(options ...IteratorOptions) Iterator[map[string]any] {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return v.window.Iterator(opts)
+}
+
+// Copy materializes the view (or its range) into a new DataFrame.
+func (v *DataFrameView) Copy(options ...RangeOptions) *DataFrame {
+	c := v.window.Copy(options...)
+	for i, s := range c.Series {
+		c.Series[i] = materialize(s)
+	}
+	return c
+}
+
+// Table will produce the view in a table.
+func (v *DataFrameView) Table(options ...TableOptions) string {
+	opts := DefaultOptions(options...)
+	opts.DontLock = true
+
+	return v.window.Table(opts)
+}
+
+// String implements the fmt.Stringer interface.
+func (v *DataFrameView) String() string {
+	return v.window.String()
+}
+
+// RLock read-locks series of the DataFrame.
+func (v *DataFrameView) RLock() {
+	for _, s := range v.Series {
+		s.RLock()
+	}
+}
+
+// RUnlock read-unlocks series of the DataFrame.
+func (v *DataFrameView) RUnlock() {
+	for _, s := range v.Series {
+		s.RUnlock()
+	}
+}
+
+// materialize returns a copy of the range of the window with its own values.
+// Copy of the window would share values with the parent series, which is
+// not marked as shared and changes them in place.
+func materialize(window SeriesAny, options ...RangeOptions) SeriesAny {
+	c := window.CopyAny(options...)
+
+	m := c.emptyCopy(c.NRows(dontLock))
+	m.appendSeries(c)
+	return m
+}
+
+// viewLimits returns rows [start, end) of the range of n rows
+func viewLimits(n int, r RangeOptions) (int, int) {
+	if n == 0 {
+		return 0, 0
+	}
+
+	start, end, err := r.Limits(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return start, end + 1
+}
+
+// headEnd returns the number of the first n rows of length rows
+func headEnd(length, n int) int {
+	if n < 0 {
+		panic("number of rows must not be negative")
+	}
+	if n > length {
+		return length
+	}
+	return n
+}